@@ -30,4 +30,7 @@ type ConnectionAPI interface {
 
 	// ReadEnabledClients retrieves the enabled clients for a connection.
 	ReadEnabledClients(ctx context.Context, id string, opts ...management.RequestOption) (c *management.ConnectionEnabledClientList, err error)
+
+	// UpdateEnabledClients enables or disables clients for a connection.
+	UpdateEnabledClients(ctx context.Context, id string, c []management.ConnectionEnabledClient, opts ...management.RequestOption) (err error)
 }
@@ -1,3 +1,5 @@
+//go:generate mockgen -source=http_client.go -destination=mock/http_client_mock.go -package=mock
+
 package auth0
 
 import (
@@ -1,3 +1,5 @@
+//go:generate mockgen -source=jobs.go -destination=mock/jobs_mock.go -package=mock
+
 package auth0
 
 import (
@@ -171,3 +171,22 @@ func (mr *MockConnectionAPIMockRecorder) Update(ctx, id, c interface{}, opts ...
 	varargs := append([]interface{}{ctx, id, c}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockConnectionAPI)(nil).Update), varargs...)
 }
+
+// UpdateEnabledClients mocks base method.
+func (m *MockConnectionAPI) UpdateEnabledClients(ctx context.Context, id string, c []management.ConnectionEnabledClient, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, c}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEnabledClients", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnabledClients indicates an expected call of UpdateEnabledClients.
+func (mr *MockConnectionAPIMockRecorder) UpdateEnabledClients(ctx, id, c interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, c}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnabledClients", reflect.TypeOf((*MockConnectionAPI)(nil).UpdateEnabledClients), varargs...)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: http_client.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+
+	management "github.com/auth0/go-auth0/management"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockHTTPClientAPI is a mock of HTTPClientAPI interface.
+type MockHTTPClientAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockHTTPClientAPIMockRecorder
+}
+
+// MockHTTPClientAPIMockRecorder is the mock recorder for MockHTTPClientAPI.
+type MockHTTPClientAPIMockRecorder struct {
+	mock *MockHTTPClientAPI
+}
+
+// NewMockHTTPClientAPI creates a new mock instance.
+func NewMockHTTPClientAPI(ctrl *gomock.Controller) *MockHTTPClientAPI {
+	mock := &MockHTTPClientAPI{ctrl: ctrl}
+	mock.recorder = &MockHTTPClientAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHTTPClientAPI) EXPECT() *MockHTTPClientAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockHTTPClientAPI) Do(req *http.Request) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", req)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockHTTPClientAPIMockRecorder) Do(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockHTTPClientAPI)(nil).Do), req)
+}
+
+// NewRequest mocks base method.
+func (m *MockHTTPClientAPI) NewRequest(ctx context.Context, method, uri string, payload interface{}, options ...management.RequestOption) (*http.Request, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, method, uri, payload}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewRequest", varargs...)
+	ret0, _ := ret[0].(*http.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewRequest indicates an expected call of NewRequest.
+func (mr *MockHTTPClientAPIMockRecorder) NewRequest(ctx, method, uri, payload interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, method, uri, payload}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRequest", reflect.TypeOf((*MockHTTPClientAPI)(nil).NewRequest), varargs...)
+}
+
+// Request mocks base method.
+func (m *MockHTTPClientAPI) Request(ctx context.Context, method, uri string, payload interface{}, options ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, method, uri, payload}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Request", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockHTTPClientAPIMockRecorder) Request(ctx, method, uri, payload interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, method, uri, payload}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockHTTPClientAPI)(nil).Request), varargs...)
+}
+
+// URI mocks base method.
+func (m *MockHTTPClientAPI) URI(path ...string) string {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range path {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "URI", varargs...)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// URI indicates an expected call of URI.
+func (mr *MockHTTPClientAPIMockRecorder) URI(path ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockHTTPClientAPI)(nil).URI), path...)
+}
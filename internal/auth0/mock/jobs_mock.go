@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: jobs.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	management "github.com/auth0/go-auth0/management"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockJobsAPI is a mock of JobsAPI interface.
+type MockJobsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobsAPIMockRecorder
+}
+
+// MockJobsAPIMockRecorder is the mock recorder for MockJobsAPI.
+type MockJobsAPIMockRecorder struct {
+	mock *MockJobsAPI
+}
+
+// NewMockJobsAPI creates a new mock instance.
+func NewMockJobsAPI(ctrl *gomock.Controller) *MockJobsAPI {
+	mock := &MockJobsAPI{ctrl: ctrl}
+	mock.recorder = &MockJobsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobsAPI) EXPECT() *MockJobsAPIMockRecorder {
+	return m.recorder
+}
+
+// ExportUsers mocks base method.
+func (m *MockJobsAPI) ExportUsers(ctx context.Context, j *management.Job, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, j}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportUsers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportUsers indicates an expected call of ExportUsers.
+func (mr *MockJobsAPIMockRecorder) ExportUsers(ctx, j interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, j}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUsers", reflect.TypeOf((*MockJobsAPI)(nil).ExportUsers), varargs...)
+}
+
+// ImportUsers mocks base method.
+func (m *MockJobsAPI) ImportUsers(ctx context.Context, j *management.Job, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, j}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportUsers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportUsers indicates an expected call of ImportUsers.
+func (mr *MockJobsAPIMockRecorder) ImportUsers(ctx, j interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, j}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUsers", reflect.TypeOf((*MockJobsAPI)(nil).ImportUsers), varargs...)
+}
+
+// Read mocks base method.
+func (m *MockJobsAPI) Read(ctx context.Context, id string, opts ...management.RequestOption) (*management.Job, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Read", varargs...)
+	ret0, _ := ret[0].(*management.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockJobsAPIMockRecorder) Read(ctx, id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockJobsAPI)(nil).Read), varargs...)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockJobsAPI) VerifyEmail(ctx context.Context, j *management.Job, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, j}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockJobsAPIMockRecorder) VerifyEmail(ctx, j interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, j}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockJobsAPI)(nil).VerifyEmail), varargs...)
+}
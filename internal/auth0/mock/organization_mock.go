@@ -35,6 +35,83 @@ func (m *MockOrganizationAPI) EXPECT() *MockOrganizationAPIMockRecorder {
 	return m.recorder
 }
 
+// AddConnection mocks base method.
+func (m *MockOrganizationAPI) AddConnection(ctx context.Context, id string, c *management.OrganizationConnection, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, c}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddConnection", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddConnection indicates an expected call of AddConnection.
+func (mr *MockOrganizationAPIMockRecorder) AddConnection(ctx, id, c interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, c}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddConnection", reflect.TypeOf((*MockOrganizationAPI)(nil).AddConnection), varargs...)
+}
+
+// AddMembers mocks base method.
+func (m *MockOrganizationAPI) AddMembers(ctx context.Context, id string, memberIDs []string, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, memberIDs}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddMembers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMembers indicates an expected call of AddMembers.
+func (mr *MockOrganizationAPIMockRecorder) AddMembers(ctx, id, memberIDs interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, memberIDs}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMembers", reflect.TypeOf((*MockOrganizationAPI)(nil).AddMembers), varargs...)
+}
+
+// AssignMemberRoles mocks base method.
+func (m *MockOrganizationAPI) AssignMemberRoles(ctx context.Context, id, memberID string, roles []string, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, memberID, roles}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssignMemberRoles", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignMemberRoles indicates an expected call of AssignMemberRoles.
+func (mr *MockOrganizationAPIMockRecorder) AssignMemberRoles(ctx, id, memberID, roles interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, memberID, roles}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignMemberRoles", reflect.TypeOf((*MockOrganizationAPI)(nil).AssignMemberRoles), varargs...)
+}
+
+// Connection mocks base method.
+func (m *MockOrganizationAPI) Connection(ctx context.Context, id, connectionID string, opts ...management.RequestOption) (*management.OrganizationConnection, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, connectionID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Connection", varargs...)
+	ret0, _ := ret[0].(*management.OrganizationConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Connection indicates an expected call of Connection.
+func (mr *MockOrganizationAPIMockRecorder) Connection(ctx, id, connectionID interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, connectionID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connection", reflect.TypeOf((*MockOrganizationAPI)(nil).Connection), varargs...)
+}
+
 // Connections mocks base method.
 func (m *MockOrganizationAPI) Connections(ctx context.Context, id string, opts ...management.RequestOption) (*management.OrganizationConnectionList, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +208,25 @@ func (mr *MockOrganizationAPIMockRecorder) Delete(ctx, id interface{}, opts ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganizationAPI)(nil).Delete), varargs...)
 }
 
+// DeleteConnection mocks base method.
+func (m *MockOrganizationAPI) DeleteConnection(ctx context.Context, id, connectionID string, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, connectionID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConnection", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConnection indicates an expected call of DeleteConnection.
+func (mr *MockOrganizationAPIMockRecorder) DeleteConnection(ctx, id, connectionID interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, connectionID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnection", reflect.TypeOf((*MockOrganizationAPI)(nil).DeleteConnection), varargs...)
+}
+
 // DeleteDiscoveryDomain mocks base method.
 func (m *MockOrganizationAPI) DeleteDiscoveryDomain(ctx context.Context, id, domainID string, opts ...management.RequestOption) error {
 	m.ctrl.T.Helper()
@@ -169,6 +265,44 @@ func (mr *MockOrganizationAPIMockRecorder) DeleteInvitation(ctx, id, invitationI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInvitation", reflect.TypeOf((*MockOrganizationAPI)(nil).DeleteInvitation), varargs...)
 }
 
+// DeleteMemberRoles mocks base method.
+func (m *MockOrganizationAPI) DeleteMemberRoles(ctx context.Context, id, memberID string, roles []string, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, memberID, roles}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMemberRoles", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMemberRoles indicates an expected call of DeleteMemberRoles.
+func (mr *MockOrganizationAPIMockRecorder) DeleteMemberRoles(ctx, id, memberID, roles interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, memberID, roles}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMemberRoles", reflect.TypeOf((*MockOrganizationAPI)(nil).DeleteMemberRoles), varargs...)
+}
+
+// DeleteMembers mocks base method.
+func (m *MockOrganizationAPI) DeleteMembers(ctx context.Context, id string, memberIDs []string, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, memberIDs}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMembers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMembers indicates an expected call of DeleteMembers.
+func (mr *MockOrganizationAPIMockRecorder) DeleteMembers(ctx, id, memberIDs interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, memberIDs}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMembers", reflect.TypeOf((*MockOrganizationAPI)(nil).DeleteMembers), varargs...)
+}
+
 // DiscoveryDomain mocks base method.
 func (m *MockOrganizationAPI) DiscoveryDomain(ctx context.Context, id, domainID string, opts ...management.RequestOption) (*management.OrganizationDiscoveryDomain, error) {
 	m.ctrl.T.Helper()
@@ -329,6 +463,26 @@ func (mr *MockOrganizationAPIMockRecorder) Read(ctx, id interface{}, opts ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockOrganizationAPI)(nil).Read), varargs...)
 }
 
+// ReadByName mocks base method.
+func (m *MockOrganizationAPI) ReadByName(ctx context.Context, name string, opts ...management.RequestOption) (*management.Organization, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadByName", varargs...)
+	ret0, _ := ret[0].(*management.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadByName indicates an expected call of ReadByName.
+func (mr *MockOrganizationAPIMockRecorder) ReadByName(ctx, name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByName", reflect.TypeOf((*MockOrganizationAPI)(nil).ReadByName), varargs...)
+}
+
 // Update mocks base method.
 func (m *MockOrganizationAPI) Update(ctx context.Context, id string, o *management.Organization, opts ...management.RequestOption) error {
 	m.ctrl.T.Helper()
@@ -348,6 +502,25 @@ func (mr *MockOrganizationAPIMockRecorder) Update(ctx, id, o interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationAPI)(nil).Update), varargs...)
 }
 
+// UpdateConnection mocks base method.
+func (m *MockOrganizationAPI) UpdateConnection(ctx context.Context, id, connectionID string, c *management.OrganizationConnection, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, connectionID, c}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateConnection", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConnection indicates an expected call of UpdateConnection.
+func (mr *MockOrganizationAPIMockRecorder) UpdateConnection(ctx, id, connectionID, c interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, connectionID, c}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnection", reflect.TypeOf((*MockOrganizationAPI)(nil).UpdateConnection), varargs...)
+}
+
 // UpdateDiscoveryDomain mocks base method.
 func (m *MockOrganizationAPI) UpdateDiscoveryDomain(ctx context.Context, id, domainID string, d *management.OrganizationDiscoveryDomain, opts ...management.RequestOption) error {
 	m.ctrl.T.Helper()
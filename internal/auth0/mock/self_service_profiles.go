@@ -54,6 +54,25 @@ func (mr *MockSelfServiceProfileAPIMockRecorder) Create(ctx, p interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSelfServiceProfileAPI)(nil).Create), varargs...)
 }
 
+// CreateTicket mocks base method.
+func (m *MockSelfServiceProfileAPI) CreateTicket(ctx context.Context, id string, t *management.SelfServiceProfileTicket, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, t}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTicket", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTicket indicates an expected call of CreateTicket.
+func (mr *MockSelfServiceProfileAPIMockRecorder) CreateTicket(ctx, id, t interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, t}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockSelfServiceProfileAPI)(nil).CreateTicket), varargs...)
+}
+
 // Delete mocks base method.
 func (m *MockSelfServiceProfileAPI) Delete(ctx context.Context, id string, opts ...management.RequestOption) error {
 	m.ctrl.T.Helper()
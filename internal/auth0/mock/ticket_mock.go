@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ticket.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	management "github.com/auth0/go-auth0/management"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTicketAPI is a mock of TicketAPI interface.
+type MockTicketAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketAPIMockRecorder
+}
+
+// MockTicketAPIMockRecorder is the mock recorder for MockTicketAPI.
+type MockTicketAPIMockRecorder struct {
+	mock *MockTicketAPI
+}
+
+// NewMockTicketAPI creates a new mock instance.
+func NewMockTicketAPI(ctrl *gomock.Controller) *MockTicketAPI {
+	mock := &MockTicketAPI{ctrl: ctrl}
+	mock.recorder = &MockTicketAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketAPI) EXPECT() *MockTicketAPIMockRecorder {
+	return m.recorder
+}
+
+// ChangePassword mocks base method.
+func (m *MockTicketAPI) ChangePassword(ctx context.Context, t *management.Ticket, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, t}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangePassword", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockTicketAPIMockRecorder) ChangePassword(ctx, t interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, t}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockTicketAPI)(nil).ChangePassword), varargs...)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockTicketAPI) VerifyEmail(ctx context.Context, t *management.Ticket, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, t}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockTicketAPIMockRecorder) VerifyEmail(ctx, t interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, t}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockTicketAPI)(nil).VerifyEmail), varargs...)
+}
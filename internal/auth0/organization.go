@@ -19,6 +19,11 @@ type OrganizationAPI interface {
 	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_organizations_by_id
 	Read(ctx context.Context, id string, opts ...management.RequestOption) (*management.Organization, error)
 
+	// ReadByName retrieves an organization by its name.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_name_by_name
+	ReadByName(ctx context.Context, name string, opts ...management.RequestOption) (*management.Organization, error)
+
 	// Update an organization.
 	//
 	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/patch_organizations_by_id
@@ -44,11 +49,51 @@ type OrganizationAPI interface {
 	// See: https://auth0.com/docs/api/management/v2#!/Organizations/get_organization_member_roles
 	MemberRoles(ctx context.Context, id string, userID string, opts ...management.RequestOption) (r *management.OrganizationMemberRoleList, err error)
 
+	// AddMembers adds members to an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_members
+	AddMembers(ctx context.Context, id string, memberIDs []string, opts ...management.RequestOption) (err error)
+
+	// DeleteMembers removes members from an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/delete_members
+	DeleteMembers(ctx context.Context, id string, memberIDs []string, opts ...management.RequestOption) (err error)
+
+	// AssignMemberRoles assigns roles to a member of an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_organization_member_roles
+	AssignMemberRoles(ctx context.Context, id string, memberID string, roles []string, opts ...management.RequestOption) (err error)
+
+	// DeleteMemberRoles removes roles from a member of an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/delete_organization_member_roles
+	DeleteMemberRoles(ctx context.Context, id string, memberID string, roles []string, opts ...management.RequestOption) (err error)
+
 	// Connections retrieves connections enabled for an organization.
 	//
 	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_enabled_connections
 	Connections(ctx context.Context, id string, opts ...management.RequestOption) (c *management.OrganizationConnectionList, err error)
 
+	// Connection retrieves an enabled connection of an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_enabled_connections_by_connectionId
+	Connection(ctx context.Context, id string, connectionID string, opts ...management.RequestOption) (c *management.OrganizationConnection, err error)
+
+	// AddConnection enables a connection for an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_enabled_connections
+	AddConnection(ctx context.Context, id string, c *management.OrganizationConnection, opts ...management.RequestOption) (err error)
+
+	// UpdateConnection updates an enabled connection of an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/patch_enabled_connections_by_connectionId
+	UpdateConnection(ctx context.Context, id string, connectionID string, c *management.OrganizationConnection, opts ...management.RequestOption) (err error)
+
+	// DeleteConnection disables a connection for an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/delete_enabled_connections_by_connectionId
+	DeleteConnection(ctx context.Context, id string, connectionID string, opts ...management.RequestOption) (err error)
+
 	// DiscoveryDomains retrieves the discovery domains for an organization.
 	DiscoveryDomains(ctx context.Context, id string, opts ...management.RequestOption) (d *management.DiscoveryDomainList, err error)
 
@@ -26,4 +26,7 @@ type SelfServiceProfileAPI interface {
 
 	// GetCustomText retrieves text customizations for a given self-service profile, language and Self Service SSO Flow page.
 	GetCustomText(ctx context.Context, id string, language string, page string, opts ...management.RequestOption) (payload map[string]interface{}, err error)
+
+	// CreateTicket creates a sso-access ticket to initiate the Self Service SSO Flow.
+	CreateTicket(ctx context.Context, id string, t *management.SelfServiceProfileTicket, opts ...management.RequestOption) error
 }
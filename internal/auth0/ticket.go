@@ -0,0 +1,17 @@
+//go:generate mockgen -source=ticket.go -destination=mock/ticket_mock.go -package=mock
+
+package auth0
+
+import (
+	"context"
+
+	"github.com/auth0/go-auth0/management"
+)
+
+type TicketAPI interface {
+	// VerifyEmail creates a ticket to verify a user's email address.
+	VerifyEmail(ctx context.Context, t *management.Ticket, opts ...management.RequestOption) error
+
+	// ChangePassword creates a password change ticket for a user.
+	ChangePassword(ctx context.Context, t *management.Ticket, opts ...management.RequestOption) error
+}
@@ -34,4 +34,34 @@ var (
 
 	//go:embed data/action-template-event-stream.js
 	actionTemplateEventStream string
+
+	//go:embed data/action-event-post-login.json
+	actionEventPostLogin string
+
+	//go:embed data/action-event-credentials-exchange.json
+	actionEventCredentialsExchange string
+
+	//go:embed data/action-event-pre-user-registration.json
+	actionEventPreUserRegistration string
+
+	//go:embed data/action-event-post-user-registration.json
+	actionEventPostUserRegistration string
+
+	//go:embed data/action-event-post-change-password.json
+	actionEventPostChangePassword string
+
+	//go:embed data/action-event-send-phone-message.json
+	actionEventSendPhoneMessage string
+
+	//go:embed data/action-event-custom-email-provider.json
+	actionEventCustomEmailProvider string
+
+	//go:embed data/action-event-custom-phone-provider.json
+	actionEventCustomPhoneProvider string
+
+	//go:embed data/action-event-event-stream.json
+	actionEventEventStream string
+
+	//go:embed data/action-test-runner.js
+	actionTestRunner string
 )
@@ -0,0 +1,277 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+// actionTestTimeout bounds how long an action is allowed to run locally.
+const actionTestTimeout = 20 * time.Second
+
+var (
+	actionTestTrigger = Flag{
+		Name:      "Trigger",
+		LongForm:  "trigger",
+		ShortForm: "t",
+		Help: "Trigger to simulate. Defaults to the trigger supported by the action, " +
+			"or post-login when testing a local file.",
+	}
+
+	actionTestEvent = Flag{
+		Name:      "Event",
+		LongForm:  "event",
+		ShortForm: "e",
+		Help:      "Path to a JSON file with the event passed to the action. Defaults to a sample event for the trigger.",
+	}
+
+	actionEvents = map[string]string{
+		"post-login":             actionEventPostLogin,
+		"credentials-exchange":   actionEventCredentialsExchange,
+		"pre-user-registration":  actionEventPreUserRegistration,
+		"post-user-registration": actionEventPostUserRegistration,
+		"post-change-password":   actionEventPostChangePassword,
+		"send-phone-message":     actionEventSendPhoneMessage,
+		"custom-email-provider":  actionEventCustomEmailProvider,
+		"custom-phone-provider":  actionEventCustomPhoneProvider,
+		"event-stream":           actionEventEventStream,
+	}
+)
+
+func testActionCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Target  string
+		Trigger string
+		Event   string
+		Secrets map[string]string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "test [action-id|file]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Run an action locally",
+		Long: "Run the code of an action locally with Node.js against a sample event, without deploying it.\n\n" +
+			"The action receives a stub `api` object that records every call made on it, such as " +
+			"`api.accessToken.setCustomClaim` or `api.access.deny`, instead of executing it. " +
+			"The recorded calls are printed once the action completes.\n\n" +
+			"The argument is either the ID of an existing action or the path to a local JavaScript file. " +
+			"Node.js must be installed. Dependencies are resolved from the `node_modules` directory of the " +
+			"current working directory.",
+		Example: `  auth0 actions test
+  auth0 actions test <action-id>
+  auth0 actions test <action-id> --event event.json
+  auth0 actions test ./action.js --trigger post-login --event event.json
+  auth0 actions test ./action.js -t post-login -e event.json --secret "API_KEY=value" --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := actionID.Pick(cmd, &inputs.Target, cli.actionPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.Target = args[0]
+			}
+
+			code, trigger, err := cli.actionTestCode(cmd.Context(), inputs.Target, inputs.Trigger)
+			if err != nil {
+				return err
+			}
+
+			event, err := actionTestEventPayload(trigger, inputs.Event, inputs.Secrets)
+			if err != nil {
+				return err
+			}
+
+			var result *display.ActionTestResult
+			if err := ansi.Waiting(func() (err error) {
+				result, err = runActionLocally(cmd.Context(), code, event, actionTriggerHandler(trigger))
+				return err
+			}); err != nil {
+				return err
+			}
+
+			cli.renderer.ActionTestResult(result)
+
+			if result.Error != "" {
+				return fmt.Errorf("the action failed: %s", result.Error)
+			}
+
+			return nil
+		},
+	}
+
+	actionTestTrigger.RegisterString(cmd, &inputs.Trigger, "")
+	actionTestEvent.RegisterString(cmd, &inputs.Event, "")
+	actionSecret.RegisterStringMap(cmd, &inputs.Secrets, nil)
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+// actionTestCode returns the code to run and the trigger to simulate. The
+// target is read from disk when it's an existing file, otherwise it's treated
+// as the ID of an action.
+func (c *cli) actionTestCode(ctx context.Context, target, trigger string) (string, string, error) {
+	if info, err := os.Stat(target); err == nil && !info.IsDir() {
+		code, err := os.ReadFile(target)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read action code from %q: %w", target, err)
+		}
+
+		if trigger == "" {
+			trigger = "post-login"
+		}
+
+		return string(code), trigger, nil
+	}
+
+	action, err := c.api.Action.Read(ctx, target)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read action with ID %q: %w", target, err)
+	}
+
+	if trigger == "" && len(action.SupportedTriggers) > 0 {
+		trigger = action.SupportedTriggers[0].GetID()
+	}
+
+	return action.GetCode(), trigger, nil
+}
+
+// actionTestEventPayload builds the event passed to the action, taken either from
+// the given file or from the sample event of the trigger, with the secrets merged in.
+func actionTestEventPayload(trigger, file string, secrets map[string]string) ([]byte, error) {
+	var raw []byte
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read event from %q: %w", file, err)
+		}
+		raw = content
+	} else {
+		sample, ok := actionEvents[trigger]
+		if !ok {
+			return nil, fmt.Errorf("there is no sample event for the %q trigger, please pass one with --event", trigger)
+		}
+		raw = []byte(sample)
+	}
+
+	var event map[string]interface{}
+	if err := json.Unmarshal(raw, &event); err != nil {
+		return nil, fmt.Errorf("failed to parse event: %w", err)
+	}
+	if event == nil {
+		event = map[string]interface{}{}
+	}
+
+	if len(secrets) > 0 {
+		merged, _ := event["secrets"].(map[string]interface{})
+		if merged == nil {
+			merged = map[string]interface{}{}
+		}
+		for key, value := range secrets {
+			merged[key] = value
+		}
+		event["secrets"] = merged
+	}
+
+	return json.Marshal(event)
+}
+
+// actionTriggerHandler returns the name of the function
+// an action exports to handle the given trigger.
+func actionTriggerHandler(trigger string) string {
+	if trigger == "password-reset-post-challenge" {
+		return "onExecutePostChallenge"
+	}
+
+	var handler strings.Builder
+	handler.WriteString("onExecute")
+	for _, part := range strings.Split(trigger, "-") {
+		if part == "" {
+			continue
+		}
+		handler.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return handler.String()
+}
+
+// runActionLocally spawns a Node.js process that runs the action's
+// handler against the event and records the calls made on the api object.
+func runActionLocally(ctx context.Context, code string, event []byte, handler string) (*display.ActionTestResult, error) {
+	if _, err := exec.LookPath("node"); err != nil {
+		return nil, errors.New("node is required to test actions locally but was not found. Please install Node.js and try again")
+	}
+
+	dir, err := os.MkdirTemp("", "auth0-action-test-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string][]byte{
+		"runner.js":  []byte(actionTestRunner),
+		"action.js":  []byte(code),
+		"event.json": event,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0600); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	resultFile := filepath.Join(dir, "result.json")
+
+	ctx, cancel := context.WithTimeout(ctx, actionTestTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(
+		ctx,
+		"node",
+		filepath.Join(dir, "runner.js"),
+		filepath.Join(dir, "action.js"),
+		filepath.Join(dir, "event.json"),
+		handler,
+		resultFile,
+	)
+
+	cmd.Env = os.Environ()
+	if cwd, err := os.Getwd(); err == nil {
+		cmd.Env = append(cmd.Env, "NODE_PATH="+filepath.Join(cwd, "node_modules"))
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("the action did not complete within %s", actionTestTimeout)
+		}
+		return nil, fmt.Errorf("failed to run the action: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	output, err := os.ReadFile(resultFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the result of the action: %w", err)
+	}
+
+	var result display.ActionTestResult
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse the result of the action: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestActionTriggerHandler(t *testing.T) {
+	assert.Equal(t, "onExecutePostLogin", actionTriggerHandler("post-login"))
+	assert.Equal(t, "onExecuteCredentialsExchange", actionTriggerHandler("credentials-exchange"))
+	assert.Equal(t, "onExecuteSendPhoneMessage", actionTriggerHandler("send-phone-message"))
+	assert.Equal(t, "onExecutePostChallenge", actionTriggerHandler("password-reset-post-challenge"))
+}
+
+func TestActionTestEventPayload(t *testing.T) {
+	t.Run("every trigger with a code template has a sample event", func(t *testing.T) {
+		for trigger := range actionTemplates {
+			_, err := actionTestEventPayload(trigger, "", nil)
+			assert.NoError(t, err, trigger)
+		}
+	})
+
+	t.Run("merges the secrets into the event", func(t *testing.T) {
+		payload, err := actionTestEventPayload("post-login", "", map[string]string{"API_KEY": "value"})
+		require.NoError(t, err)
+
+		var event map[string]interface{}
+		require.NoError(t, json.Unmarshal(payload, &event))
+		assert.Equal(t, map[string]interface{}{"API_KEY": "value"}, event["secrets"])
+	})
+
+	t.Run("requires an event for triggers without a sample", func(t *testing.T) {
+		_, err := actionTestEventPayload("password-reset-post-challenge", "", nil)
+		assert.EqualError(t, err, `there is no sample event for the "password-reset-post-challenge" trigger, please pass one with --event`)
+	})
+}
+
+func TestRunActionLocally(t *testing.T) {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("node is not installed")
+	}
+
+	event, err := actionTestEventPayload("post-login", "", nil)
+	require.NoError(t, err)
+
+	t.Run("records the calls made on the api object", func(t *testing.T) {
+		code := `exports.onExecutePostLogin = async (event, api) => {
+  console.log("user", event.user.user_id);
+  api.accessToken.setCustomClaim("https://example.com/roles", ["admin"]).access.deny("blocked");
+};`
+
+		result, err := runActionLocally(context.Background(), code, event, "onExecutePostLogin")
+		require.NoError(t, err)
+
+		assert.Equal(t, []display.ActionTestCommand{
+			{Name: "api.accessToken.setCustomClaim", Args: []interface{}{"https://example.com/roles", []interface{}{"admin"}}},
+			{Name: "api.access.deny", Args: []interface{}{"blocked"}},
+		}, result.Commands)
+		assert.Len(t, result.Logs, 1)
+		assert.Empty(t, result.Error)
+	})
+
+	t.Run("reports errors thrown by the action", func(t *testing.T) {
+		code := `exports.onExecutePostLogin = async () => { throw new Error("boom"); };`
+
+		result, err := runActionLocally(context.Background(), code, event, "onExecutePostLogin")
+		require.NoError(t, err)
+		assert.Equal(t, "boom", result.Error)
+	})
+
+	t.Run("reports a missing handler", func(t *testing.T) {
+		result, err := runActionLocally(context.Background(), `exports.foo = () => {};`, event, "onExecutePostLogin")
+		require.NoError(t, err)
+		assert.Equal(t, "the action does not export an onExecutePostLogin handler", result.Error)
+	})
+}
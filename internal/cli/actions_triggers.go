@@ -0,0 +1,613 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+const actionBindingRefTypeActionID = "action_id"
+
+var (
+	actionTriggerID = Argument{
+		Name: "Trigger",
+		Help: "ID of the trigger, e.g. post-login.",
+	}
+
+	actionTriggerActions = Flag{
+		Name:       "Actions",
+		LongForm:   "actions",
+		ShortForm:  "a",
+		Help:       "IDs of the actions.",
+		IsRequired: true,
+	}
+
+	actionTriggerPosition = Flag{
+		Name:      "Position",
+		LongForm:  "position",
+		ShortForm: "p",
+		Help:      "Position (starting at 1) at which the actions are inserted. Defaults to the end of the trigger.",
+	}
+
+	actionTriggerDryRun = Flag{
+		Name:     "Dry Run",
+		LongForm: "dry-run",
+		Help:     "Preview the change in the order of the bound actions without saving it.",
+	}
+)
+
+func actionsTriggersCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triggers",
+		Short: "Manage the actions bound to triggers",
+		Long: "Triggers are the points in the Auth0 pipeline where deployed actions run, such as post-login. " +
+			"Bind actions to a trigger and control the order in which they are executed.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listActionTriggersCmd(cli))
+	cmd.AddCommand(showActionTriggerCmd(cli))
+	cmd.AddCommand(bindActionTriggerCmd(cli))
+	cmd.AddCommand(unbindActionTriggerCmd(cli))
+	cmd.AddCommand(reorderActionTriggerCmd(cli))
+
+	return cmd
+}
+
+func listActionTriggersCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List the available triggers",
+		Long:    "List the triggers that actions can be bound to.",
+		Example: `  auth0 actions triggers list
+  auth0 actions triggers ls
+  auth0 actions triggers ls --json
+  auth0 actions triggers ls --json-compact
+  auth0 actions triggers ls --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			triggers, err := getCurrentTriggers(cmd.Context(), cli)
+			if err != nil {
+				return fmt.Errorf("failed to list triggers: %w", err)
+			}
+
+			cli.renderer.ActionTriggerList(triggers)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	return cmd
+}
+
+func showActionTriggerCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Trigger string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show the actions bound to a trigger",
+		Long:  "Display the actions bound to a trigger in the order in which they are executed.",
+		Example: `  auth0 actions triggers show
+  auth0 actions triggers show <trigger-id>
+  auth0 actions triggers show post-login --json
+  auth0 actions triggers show post-login --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := actionTriggerID.Pick(cmd, &inputs.Trigger, cli.actionTriggerPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.Trigger = args[0]
+			}
+
+			var bindings []*management.ActionBinding
+			if err := ansi.Waiting(func() (err error) {
+				bindings, err = cli.getActionBindings(cmd.Context(), inputs.Trigger)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read the bindings of trigger %q: %w", inputs.Trigger, err)
+			}
+
+			cli.renderer.ActionBindingList(inputs.Trigger, bindings)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	return cmd
+}
+
+func bindActionTriggerCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Trigger  string
+		Actions  []string
+		Position int
+		DryRun   bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "bind",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Bind actions to a trigger",
+		Long: "Bind one or more deployed actions to a trigger.\n\n" +
+			"The actions are appended to the end of the trigger unless a `--position` is given. " +
+			"Use `--dry-run` to preview the resulting order without saving it.",
+		Example: `  auth0 actions triggers bind
+  auth0 actions triggers bind post-login
+  auth0 actions triggers bind post-login --actions <action-id>
+  auth0 actions triggers bind post-login --actions <action-id1>,<action-id2> --position 1
+  auth0 actions triggers bind post-login -a <action-id> --dry-run
+  auth0 actions triggers bind post-login -a <action-id> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := actionTriggerID.Pick(cmd, &inputs.Trigger, cli.actionTriggerPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.Trigger = args[0]
+			}
+
+			if err := actionTriggerActions.PickMany(cmd, &inputs.Actions, cli.actionPickerOptions); err != nil {
+				return err
+			}
+
+			if len(inputs.Actions) == 0 {
+				return errors.New("at least one action ID is required")
+			}
+
+			var (
+				current []*management.ActionBinding
+				actions []*management.Action
+			)
+			if err := ansi.Waiting(func() (err error) {
+				if current, err = cli.getActionBindings(cmd.Context(), inputs.Trigger); err != nil {
+					return fmt.Errorf("failed to read the bindings of trigger %q: %w", inputs.Trigger, err)
+				}
+
+				for _, id := range inputs.Actions {
+					action, err := cli.api.Action.Read(cmd.Context(), id)
+					if err != nil {
+						return fmt.Errorf("failed to read action with ID %q: %w", id, err)
+					}
+					actions = append(actions, action)
+				}
+
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			updated, err := bindActions(inputs.Trigger, current, actions, inputs.Position)
+			if err != nil {
+				return err
+			}
+
+			return cli.updateActionBindings(cmd.Context(), inputs.Trigger, current, updated, inputs.DryRun)
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	actionTriggerActions.RegisterStringSlice(cmd, &inputs.Actions, nil)
+	actionTriggerPosition.RegisterInt(cmd, &inputs.Position, 0)
+	actionTriggerDryRun.RegisterBool(cmd, &inputs.DryRun, false)
+
+	return cmd
+}
+
+func unbindActionTriggerCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Trigger string
+		Actions []string
+		DryRun  bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unbind",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Unbind actions from a trigger",
+		Long: "Unbind one or more actions from a trigger. The actions themselves are not deleted.\n\n" +
+			"Use `--dry-run` to preview the resulting order without saving it.",
+		Example: `  auth0 actions triggers unbind
+  auth0 actions triggers unbind post-login
+  auth0 actions triggers unbind post-login --actions <action-id>
+  auth0 actions triggers unbind post-login -a <action-id1>,<action-id2> --dry-run
+  auth0 actions triggers unbind post-login -a <action-id> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := actionTriggerID.Pick(cmd, &inputs.Trigger, cli.actionTriggerPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.Trigger = args[0]
+			}
+
+			var current []*management.ActionBinding
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.getActionBindings(cmd.Context(), inputs.Trigger)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read the bindings of trigger %q: %w", inputs.Trigger, err)
+			}
+
+			if err := actionTriggerActions.PickMany(cmd, &inputs.Actions, actionBindingPickerOptions(inputs.Trigger, current)); err != nil {
+				return err
+			}
+
+			if len(inputs.Actions) == 0 {
+				return errors.New("at least one action ID is required")
+			}
+
+			updated, err := unbindActions(inputs.Trigger, current, inputs.Actions)
+			if err != nil {
+				return err
+			}
+
+			return cli.updateActionBindings(cmd.Context(), inputs.Trigger, current, updated, inputs.DryRun)
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	actionTriggerActions.RegisterStringSlice(cmd, &inputs.Actions, nil)
+	actionTriggerDryRun.RegisterBool(cmd, &inputs.DryRun, false)
+
+	return cmd
+}
+
+func reorderActionTriggerCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Trigger string
+		Actions []string
+		DryRun  bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "reorder",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Change the order of the actions bound to a trigger",
+		Long: "Change the order in which the actions bound to a trigger are executed.\n\n" +
+			"To reorder interactively, use `auth0 actions triggers reorder` and select the actions one position at a time.\n\n" +
+			"To reorder non-interactively, pass every bound action ID, in the new order, through `--actions`. " +
+			"Use `--dry-run` to preview the resulting order without saving it.",
+		Example: `  auth0 actions triggers reorder
+  auth0 actions triggers reorder post-login
+  auth0 actions triggers reorder post-login --actions <action-id2>,<action-id1>
+  auth0 actions triggers reorder post-login -a <action-id2>,<action-id1> --dry-run
+  auth0 actions triggers reorder post-login -a <action-id2>,<action-id1> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := actionTriggerID.Pick(cmd, &inputs.Trigger, cli.actionTriggerPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.Trigger = args[0]
+			}
+
+			var current []*management.ActionBinding
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.getActionBindings(cmd.Context(), inputs.Trigger)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read the bindings of trigger %q: %w", inputs.Trigger, err)
+			}
+
+			if len(current) < 2 {
+				return fmt.Errorf("the %q trigger needs at least 2 bound actions to be reordered", inputs.Trigger)
+			}
+
+			if !actionTriggerActions.IsSet(cmd) && canPrompt(cmd) {
+				order, err := askActionBindingOrder(inputs.Trigger, current)
+				if err != nil {
+					return err
+				}
+				inputs.Actions = order
+			}
+
+			updated, err := reorderActions(inputs.Trigger, current, inputs.Actions)
+			if err != nil {
+				return err
+			}
+
+			return cli.updateActionBindings(cmd.Context(), inputs.Trigger, current, updated, inputs.DryRun)
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	actionTriggerActions.RegisterStringSlice(cmd, &inputs.Actions, nil)
+	actionTriggerDryRun.RegisterBool(cmd, &inputs.DryRun, false)
+
+	return cmd
+}
+
+// updateActionBindings saves the new order of the actions bound to a trigger,
+// or only prints the difference between both orders when dryRun is set.
+func (c *cli) updateActionBindings(
+	ctx context.Context,
+	triggerID string,
+	current, updated []*management.ActionBinding,
+	dryRun bool,
+) error {
+	if dryRun {
+		printActionBindingsDiff(triggerID, current, updated)
+		return nil
+	}
+
+	var bindings []*management.ActionBinding
+	if err := ansi.Waiting(func() (err error) {
+		if err = c.api.Action.UpdateBindings(ctx, triggerID, actionBindingUpdates(updated)); err != nil {
+			return err
+		}
+
+		bindings, err = c.getActionBindings(ctx, triggerID)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to update the bindings of trigger %q: %w", triggerID, err)
+	}
+
+	c.renderer.ActionBindingList(triggerID, bindings)
+
+	return nil
+}
+
+func (c *cli) getActionBindings(ctx context.Context, triggerID string) ([]*management.ActionBinding, error) {
+	const perPage = 50
+
+	var bindings []*management.ActionBinding
+	for page := 0; ; page++ {
+		list, err := c.api.Action.Bindings(ctx, triggerID, management.Page(page), management.PerPage(perPage))
+		if err != nil {
+			return nil, err
+		}
+
+		bindings = append(bindings, list.Bindings...)
+
+		if len(list.Bindings) < perPage {
+			return bindings, nil
+		}
+	}
+}
+
+func bindActions(
+	triggerID string,
+	current []*management.ActionBinding,
+	actions []*management.Action,
+	position int,
+) ([]*management.ActionBinding, error) {
+	if position < 0 || position > len(current)+1 {
+		return nil, fmt.Errorf("position flag invalid, please pass a number between 1 and %d", len(current)+1)
+	}
+	if position == 0 {
+		position = len(current) + 1
+	}
+
+	var bindings []*management.ActionBinding
+	for _, action := range actions {
+		if !actionSupportsTrigger(action, triggerID) {
+			return nil, fmt.Errorf("action %q does not support the %q trigger", action.GetName(), triggerID)
+		}
+		if actionBindingIndex(current, action.GetID()) > -1 {
+			return nil, fmt.Errorf("action %q is already bound to the %q trigger", action.GetName(), triggerID)
+		}
+
+		bindings = append(bindings, &management.ActionBinding{
+			DisplayName: action.Name,
+			Action:      action,
+		})
+	}
+
+	return slices.Concat(current[:position-1], bindings, current[position-1:]), nil
+}
+
+func unbindActions(triggerID string, current []*management.ActionBinding, actionIDs []string) ([]*management.ActionBinding, error) {
+	for _, id := range actionIDs {
+		if actionBindingIndex(current, id) == -1 {
+			return nil, fmt.Errorf("action with ID %q is not bound to the %q trigger", id, triggerID)
+		}
+	}
+
+	var bindings []*management.ActionBinding
+	for _, binding := range current {
+		if !slices.Contains(actionIDs, binding.GetAction().GetID()) {
+			bindings = append(bindings, binding)
+		}
+	}
+
+	return bindings, nil
+}
+
+func reorderActions(triggerID string, current []*management.ActionBinding, actionIDs []string) ([]*management.ActionBinding, error) {
+	if len(actionIDs) != len(current) {
+		return nil, fmt.Errorf(
+			"expected all %d actions bound to the %q trigger, got %d. Run `auth0 actions triggers show %s` to list them",
+			len(current), triggerID, len(actionIDs), triggerID,
+		)
+	}
+
+	bindings := make([]*management.ActionBinding, 0, len(current))
+	for _, id := range actionIDs {
+		i := actionBindingIndex(current, id)
+		if i == -1 {
+			return nil, fmt.Errorf("action with ID %q is not bound to the %q trigger", id, triggerID)
+		}
+		if slices.Contains(bindings, current[i]) {
+			return nil, fmt.Errorf("action with ID %q is listed more than once", id)
+		}
+
+		bindings = append(bindings, current[i])
+	}
+
+	return bindings, nil
+}
+
+// actionBindingUpdates converts the bindings into the payload expected
+// by the Management API, which references each action by its ID.
+func actionBindingUpdates(bindings []*management.ActionBinding) []*management.ActionBinding {
+	updates := make([]*management.ActionBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		updates = append(updates, &management.ActionBinding{
+			Ref: &management.ActionBindingReference{
+				Type:  auth0.String(actionBindingRefTypeActionID),
+				Value: auth0.String(binding.GetAction().GetID()),
+			},
+			DisplayName: binding.DisplayName,
+		})
+	}
+
+	return updates
+}
+
+func actionBindingIndex(bindings []*management.ActionBinding, actionID string) int {
+	return slices.IndexFunc(bindings, func(binding *management.ActionBinding) bool {
+		return binding.GetAction().GetID() == actionID
+	})
+}
+
+func actionSupportsTrigger(action *management.Action, triggerID string) bool {
+	return slices.ContainsFunc(action.SupportedTriggers, func(trigger management.ActionTrigger) bool {
+		return trigger.GetID() == triggerID
+	})
+}
+
+func formatActionBindingOrder(bindings []*management.ActionBinding) []string {
+	lines := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		name := binding.GetDisplayName()
+		if name == "" {
+			name = binding.GetAction().GetName()
+		}
+		lines = append(lines, fmt.Sprintf("%s (%s)\n", name, binding.GetAction().GetID()))
+	}
+
+	return lines
+}
+
+func printActionBindingsDiff(triggerID string, current, updated []*management.ActionBinding) {
+	diff := difflib.UnifiedDiff{
+		A:        formatActionBindingOrder(current),
+		B:        formatActionBindingOrder(updated),
+		FromFile: "current",
+		ToFile:   "updated",
+		Context:  len(current) + len(updated),
+	}
+	text, _ := difflib.GetUnifiedDiffString(diff)
+
+	if text == "" {
+		fmt.Printf("No changes to the order of the actions bound to the %s trigger\n", triggerID)
+		return
+	}
+
+	fmt.Printf("Dry run, the %s trigger would change as follows:\n\n", triggerID)
+
+	printColoredDiffLines(os.Stdout, text)
+}
+
+// printColoredDiffLines prints the lines of a unified diff, colored by kind.
+// The lines keep their own line endings, so they're printed as they are.
+func printColoredDiffLines(w io.Writer, text string) {
+	for _, line := range strings.SplitAfter(text, "\n") {
+		switch {
+		case len(line) > 0 && line[0] == '+':
+			fmt.Fprint(w, ansi.Green(line)) // Green for additions.
+		case len(line) > 0 && line[0] == '-':
+			fmt.Fprint(w, ansi.Red(line)) // Red for deletions.
+		case len(line) > 0 && line[0] == '@':
+			fmt.Fprint(w, ansi.Cyan(line)) // Cyan for hunk headers.
+		default:
+			fmt.Fprint(w, line) // Default color.
+		}
+	}
+}
+
+func askActionBindingOrder(triggerID string, current []*management.ActionBinding) ([]string, error) {
+	remaining, err := actionBindingPickerOptions(triggerID, current)(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	var order []string
+	for position := 1; len(remaining) > 1; position++ {
+		var label string
+		if err := prompt.AskOne(&survey.Question{
+			Name: "action",
+			Prompt: &survey.Select{
+				Message: fmt.Sprintf("Select the action to run in position %d:", position),
+				Options: remaining.labels(),
+			},
+			Validate: survey.Required,
+		}, &label); err != nil {
+			return nil, handleInputError(err)
+		}
+
+		value := remaining.getValue(label)
+		order = append(order, value)
+		remaining = slices.DeleteFunc(remaining, func(o pickerOption) bool {
+			return o.value == value
+		})
+	}
+
+	return append(order, remaining[0].value), nil
+}
+
+func actionBindingPickerOptions(triggerID string, bindings []*management.ActionBinding) pickerOptionsFunc {
+	return func(_ context.Context) (pickerOptions, error) {
+		var opts pickerOptions
+		for _, binding := range bindings {
+			value := binding.GetAction().GetID()
+			label := fmt.Sprintf("%s %s", binding.GetDisplayName(), ansi.Faint("("+value+")"))
+			opts = append(opts, pickerOption{value: value, label: label})
+		}
+
+		if len(opts) == 0 {
+			return nil, fmt.Errorf("there are currently no actions bound to the %q trigger", triggerID)
+		}
+
+		return opts, nil
+	}
+}
+
+func (c *cli) actionTriggerPickerOptions(ctx context.Context) (pickerOptions, error) {
+	list, err := c.api.Action.Triggers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, trigger := range filterOutDeprecatedActionTriggers(list.Triggers) {
+		opts = append(opts, pickerOption{value: trigger.GetID(), label: trigger.GetID()})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no triggers to choose from")
+	}
+
+	return opts, nil
+}
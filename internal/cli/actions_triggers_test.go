@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func testActionBinding(id string) *management.ActionBinding {
+	return &management.ActionBinding{
+		ID:          auth0.String("binding-" + id),
+		DisplayName: auth0.String("action " + id),
+		Action:      &management.Action{ID: auth0.String(id), Name: auth0.String("action " + id)},
+	}
+}
+
+func testActionBindingIDs(bindings []*management.ActionBinding) []string {
+	var ids []string
+	for _, binding := range bindings {
+		ids = append(ids, binding.GetAction().GetID())
+	}
+	return ids
+}
+
+func TestBindActions(t *testing.T) {
+	current := []*management.ActionBinding{testActionBinding("a"), testActionBinding("b")}
+	postLogin := []management.ActionTrigger{{ID: auth0.String("post-login")}}
+	newAction := &management.Action{ID: auth0.String("c"), Name: auth0.String("action c"), SupportedTriggers: postLogin}
+
+	t.Run("appends the actions by default", func(t *testing.T) {
+		bindings, err := bindActions("post-login", current, []*management.Action{newAction}, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, testActionBindingIDs(bindings))
+		assert.Equal(t, []string{"a", "b"}, testActionBindingIDs(current))
+	})
+
+	t.Run("inserts the actions at the given position", func(t *testing.T) {
+		bindings, err := bindActions("post-login", current, []*management.Action{newAction}, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c", "a", "b"}, testActionBindingIDs(bindings))
+	})
+
+	t.Run("rejects an out of range position", func(t *testing.T) {
+		_, err := bindActions("post-login", current, []*management.Action{newAction}, 4)
+		assert.EqualError(t, err, "position flag invalid, please pass a number between 1 and 3")
+	})
+
+	t.Run("rejects actions that do not support the trigger", func(t *testing.T) {
+		_, err := bindActions("pre-user-registration", current, []*management.Action{newAction}, 0)
+		assert.EqualError(t, err, `action "action c" does not support the "pre-user-registration" trigger`)
+	})
+
+	t.Run("rejects actions that are already bound", func(t *testing.T) {
+		bound := &management.Action{ID: auth0.String("a"), Name: auth0.String("action a"), SupportedTriggers: postLogin}
+		_, err := bindActions("post-login", current, []*management.Action{bound}, 0)
+		assert.EqualError(t, err, `action "action a" is already bound to the "post-login" trigger`)
+	})
+}
+
+func TestUnbindActions(t *testing.T) {
+	current := []*management.ActionBinding{testActionBinding("a"), testActionBinding("b"), testActionBinding("c")}
+
+	bindings, err := unbindActions("post-login", current, []string{"a", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, testActionBindingIDs(bindings))
+
+	_, err = unbindActions("post-login", current, []string{"d"})
+	assert.EqualError(t, err, `action with ID "d" is not bound to the "post-login" trigger`)
+}
+
+func TestReorderActions(t *testing.T) {
+	current := []*management.ActionBinding{testActionBinding("a"), testActionBinding("b"), testActionBinding("c")}
+
+	bindings, err := reorderActions("post-login", current, []string{"c", "a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "a", "b"}, testActionBindingIDs(bindings))
+
+	_, err = reorderActions("post-login", current, []string{"c", "a"})
+	assert.ErrorContains(t, err, `expected all 3 actions bound to the "post-login" trigger, got 2`)
+
+	_, err = reorderActions("post-login", current, []string{"c", "a", "a"})
+	assert.EqualError(t, err, `action with ID "a" is listed more than once`)
+
+	_, err = reorderActions("post-login", current, []string{"c", "a", "d"})
+	assert.EqualError(t, err, `action with ID "d" is not bound to the "post-login" trigger`)
+}
+
+func TestActionBindingUpdates(t *testing.T) {
+	updates := actionBindingUpdates([]*management.ActionBinding{testActionBinding("a")})
+
+	require.Len(t, updates, 1)
+	assert.Equal(t, "action_id", updates[0].GetRef().GetType())
+	assert.Equal(t, "a", updates[0].GetRef().GetValue())
+	assert.Equal(t, "action a", updates[0].GetDisplayName())
+	assert.Nil(t, updates[0].Action)
+	assert.Nil(t, updates[0].ID)
+}
+
+func TestUpdateActionBindingsDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// No calls are expected on the API when running with --dry-run.
+	actionAPI := mock.NewMockActionAPI(ctrl)
+
+	cli := &cli{
+		api: &auth0.API{Action: actionAPI},
+	}
+
+	current := []*management.ActionBinding{testActionBinding("a"), testActionBinding("b")}
+	updated := []*management.ActionBinding{current[1], current[0]}
+
+	assert.NoError(t, cli.updateActionBindings(context.Background(), "post-login", current, updated, true))
+}
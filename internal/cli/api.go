@@ -2,15 +2,20 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/auth0/go-auth0/v3/management/core"
+	"github.com/itchyny/gojq"
 	"github.com/spf13/cobra"
 
 	"github.com/auth0/auth0-cli/internal/ansi"
@@ -34,10 +39,27 @@ var apiFlags = apiCmdFlags{
 		Name:         "QueryParams",
 		LongForm:     "query",
 		ShortForm:    "q",
-		Help:         "Query params to send with the request.",
+		Help:         "Query params to send with the request, as key=value pairs.",
 		IsRequired:   false,
 		AlwaysPrompt: false,
 	},
+	Paginate: Flag{
+		Name:     "Paginate",
+		LongForm: "paginate",
+		Help: "Fetch every page of a GET request and merge the results into a single JSON array. " +
+			"Follows page and per_page pagination, or checkpoint pagination when the from or take query param is set.",
+	},
+	Include: Flag{
+		Name:      "Include",
+		LongForm:  "include",
+		ShortForm: "i",
+		Help:      "Print the HTTP status and headers of the response before its body.",
+	},
+	JQ: Flag{
+		Name:     "JQ",
+		LongForm: "jq",
+		Help:     "Filter the JSON response with a jq expression. Strings in the result are printed without quotes.",
+	},
 }
 
 var apiValidMethods = []string{
@@ -52,6 +74,9 @@ type (
 	apiCmdFlags struct {
 		Data        Flag
 		QueryParams Flag
+		Paginate    Flag
+		Include     Flag
+		JQ          Flag
 	}
 
 	apiCmdInputs struct {
@@ -61,9 +86,21 @@ type (
 		RawURI         string
 		RawData        string
 		RawQueryParams map[string]string
+		RawJQ          string
+		Paginate       bool
+		Include        bool
 		Method         string
 		URL            *url.URL
 		Data           interface{}
+		JQ             *gojq.Code
+	}
+
+	// apiPage is a page of results of a paginated Management API response.
+	apiPage struct {
+		results []json.RawMessage
+		total   int
+		next    string
+		hasNext bool
 	}
 )
 
@@ -86,6 +123,9 @@ Additional scopes may need to be requested during authentication step via the %s
 		),
 		Example: `  auth0 api get "tenants/settings"
   auth0 api "stats/daily" -q "from=20221101" -q "to=20221118"
+  auth0 api users -q "q=email_verified:false" --paginate --jq ".[].email"
+  auth0 api "organizations/<org-id>/members" -q "take=50" --paginate
+  auth0 api get "tenants/settings" --include
   auth0 api delete "actions/actions/<action-id>" --force
   auth0 api clients --data "{\"name\":\"ssoTest\",\"app_type\":\"sso_integration\"}"
   cat data.json | auth0 api post clients`,
@@ -96,6 +136,9 @@ Additional scopes may need to be requested during authentication step via the %s
 	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation when using the delete method.")
 	apiFlags.Data.RegisterString(cmd, &inputs.RawData, "")
 	apiFlags.QueryParams.RegisterStringMap(cmd, &inputs.RawQueryParams, nil)
+	apiFlags.Paginate.RegisterBool(cmd, &inputs.Paginate, false)
+	apiFlags.Include.RegisterBool(cmd, &inputs.Include, false)
+	apiFlags.JQ.RegisterString(cmd, &inputs.RawJQ, "")
 
 	return cmd
 }
@@ -138,64 +181,306 @@ func apiCmdRun(cli *cli, inputs *apiCmdInputs) func(cmd *cobra.Command, args []s
 			}
 		}
 
-		var response *http.Response
-		if err := ansi.Waiting(func() error {
-			request, err := cli.api.HTTPClient.NewRequest(
-				cmd.Context(),
-				inputs.Method,
-				inputs.URL.String(),
-				inputs.Data,
-			)
-			if err != nil {
-				return err
-			}
+		var (
+			body []byte
+			err  error
+		)
+		if inputs.Paginate {
+			body, err = cli.apiPaginate(cmd.Context(), inputs)
+		} else {
+			body, err = cli.apiRequest(cmd.Context(), inputs, inputs.URL)
+		}
+		if err != nil {
+			return err
+		}
 
+		if len(body) == 0 {
 			if cli.debug {
-				cli.renderer.Infof("Sending the following request: %+v", map[string]interface{}{
-					"method":  request.Method,
-					"url":     request.URL.String(),
-					"payload": inputs.Data,
-				})
+				cli.renderer.Infof("Response body is empty.")
 			}
+			return nil
+		}
+
+		return cli.renderAPIResponse(body, inputs.JQ)
+	}
+}
 
-			response, err = cli.api.HTTPClient.Do(request)
+// apiRequest sends a single request to the given endpoint and returns the body of the response.
+func (c *cli) apiRequest(ctx context.Context, inputs *apiCmdInputs, endpoint *url.URL) ([]byte, error) {
+	var response *http.Response
+	if err := ansi.Waiting(func() error {
+		request, err := c.api.HTTPClient.NewRequest(
+			ctx,
+			inputs.Method,
+			endpoint.String(),
+			inputs.Data,
+		)
+		if err != nil {
 			return err
-		}); err != nil {
-			return fmt.Errorf("failed to send request: %w", err)
 		}
-		defer func() {
-			_ = response.Body.Close()
-		}()
 
-		if err := isInsufficientScopeError(response); err != nil {
-			return err
+		if c.debug {
+			c.renderer.Infof("Sending the following request: %+v", map[string]interface{}{
+				"method":  request.Method,
+				"url":     request.URL.String(),
+				"payload": inputs.Data,
+			})
 		}
 
-		rawBodyJSON, err := io.ReadAll(response.Body)
+		response, err = c.api.HTTPClient.Do(request)
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if inputs.Include {
+		c.renderAPIResponseHead(response)
+	}
+
+	if err := isInsufficientScopeError(response); err != nil {
+		return nil, err
+	}
+
+	rawBodyJSON, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return nil, newAPIResponseError(response.StatusCode, response.Header, rawBodyJSON)
+	}
+
+	return rawBodyJSON, nil
+}
+
+// apiPaginate fetches every page of a GET request and merges their results into a single JSON array.
+//
+// Requests with a from or take query param follow checkpoint pagination, continuing from the next
+// token of the response or, for responses without one such as logs, from the ID of the last result.
+// Other requests follow page and per_page pagination. Pages are fetched until the total of the
+// response is reached or, for responses without one, until a page holds no results.
+func (c *cli) apiPaginate(ctx context.Context, inputs *apiCmdInputs) ([]byte, error) {
+	endpoint := *inputs.URL
+	params := endpoint.Query()
+
+	checkpoint := params.Has("from") || params.Has("take")
+
+	sizeParam := "per_page"
+	if checkpoint {
+		sizeParam = "take"
+	}
+
+	pageSize := defaultPageSize
+	if value := params.Get(sizeParam); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 {
+			return nil, fmt.Errorf("invalid %s query param: %q", sizeParam, value)
+		}
+		pageSize = size
+	}
+	params.Set(sizeParam, strconv.Itoa(pageSize))
+
+	pageNumber := 0
+	if value := params.Get("page"); value != "" && !checkpoint {
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("invalid page query param: %q", value)
+		}
+		pageNumber = number
+	}
+
+	results := make([]json.RawMessage, 0)
+	for {
+		if !checkpoint {
+			params.Set("page", strconv.Itoa(pageNumber))
+		}
+		endpoint.RawQuery = params.Encode()
+
+		body, err := c.apiRequest(ctx, inputs, &endpoint)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if response.StatusCode >= http.StatusBadRequest {
-			return newAPIResponseError(response.StatusCode, response.Header, rawBodyJSON)
+		page, err := parseAPIPage(body)
+		if err != nil {
+			return nil, err
 		}
+		results = append(results, page.results...)
 
-		if len(rawBodyJSON) == 0 {
-			if cli.debug {
-				cli.renderer.Infof("Response body is empty.")
+		// The API caps the page size of some endpoints, so a page with fewer
+		// results than asked for isn't necessarily the last one.
+		if !checkpoint {
+			if len(page.results) == 0 || (page.total > 0 && len(results) >= page.total) {
+				break
 			}
-			return nil
+			pageNumber++
+			continue
+		}
+
+		from := page.next
+		if !page.hasNext {
+			if len(page.results) == 0 {
+				break
+			}
+			from = lastAPIResultID(page.results)
+		}
+		if from == "" || from == params.Get("from") {
+			break
+		}
+		params.Set("from", from)
+	}
+
+	merged := bytes.NewBufferString("[")
+	for index, result := range results {
+		if index > 0 {
+			merged.WriteString(",")
+		}
+		merged.Write(result)
+	}
+	merged.WriteString("]")
+
+	return merged.Bytes(), nil
+}
+
+// parseAPIPage reads the results of a page, which are either the response
+// itself or the only list within it, alongside its total and next token.
+func parseAPIPage(body []byte) (apiPage, error) {
+	var page apiPage
+
+	body = bytes.TrimSpace(body)
+	if bytes.HasPrefix(body, []byte("[")) {
+		err := json.Unmarshal(body, &page.results)
+		return page, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return page, fmt.Errorf("failed to paginate: the response is not a list of results: %w", err)
+	}
+
+	lists := 0
+	for key, value := range fields {
+		switch {
+		case key == "next":
+			page.hasNext = true
+			_ = json.Unmarshal(value, &page.next)
+		case key == "total":
+			_ = json.Unmarshal(value, &page.total)
+		case bytes.HasPrefix(bytes.TrimSpace(value), []byte("[")):
+			if err := json.Unmarshal(value, &page.results); err != nil {
+				return page, err
+			}
+			lists++
+		}
+	}
+
+	if lists != 1 {
+		return page, fmt.Errorf("failed to paginate: the response holds %d lists of results instead of 1", lists)
+	}
+
+	return page, nil
+}
+
+func lastAPIResultID(results []json.RawMessage) string {
+	if len(results) == 0 {
+		return ""
+	}
+
+	var result struct {
+		LogID string `json:"log_id"`
+		ID    string `json:"id"`
+	}
+	if err := json.Unmarshal(results[len(results)-1], &result); err != nil {
+		return ""
+	}
+
+	if result.LogID != "" {
+		return result.LogID
+	}
+
+	return result.ID
+}
+
+func (c *cli) renderAPIResponseHead(response *http.Response) {
+	keys := make([]string, 0, len(response.Header))
+	for key := range response.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var head strings.Builder
+	fmt.Fprintf(&head, "%s %s\n", response.Proto, response.Status)
+	for _, key := range keys {
+		for _, value := range response.Header[key] {
+			fmt.Fprintf(&head, "%s: %s\n", ansi.Bold(key), value)
 		}
+	}
+	head.WriteString("\n")
+
+	fmt.Fprint(c.renderer.ResultWriter, head.String())
+}
 
+// renderAPIResponse prints the JSON body of a response, or each
+// result of the jq expression when one is given.
+func (c *cli) renderAPIResponse(body []byte, query *gojq.Code) error {
+	if query == nil {
 		var prettyJSON bytes.Buffer
-		if err := json.Indent(&prettyJSON, rawBodyJSON, "", "  "); err != nil {
+		if err := json.Indent(&prettyJSON, body, "", "  "); err != nil {
 			return fmt.Errorf("failed to prepare json output: %w", err)
 		}
 
-		cli.renderer.Output(ansi.ColorizeJSON(prettyJSON.String()))
+		c.renderer.Output(ansi.ColorizeJSON(prettyJSON.String()))
 
 		return nil
 	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return fmt.Errorf("failed to prepare json output: %w", err)
+	}
+
+	var output []string
+	iter := query.Run(data)
+	for {
+		value, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		if err, ok := value.(error); ok {
+			var haltErr *gojq.HaltError
+			if errors.As(err, &haltErr) && haltErr.Value() == nil {
+				break
+			}
+			return fmt.Errorf("failed to apply jq expression: %w", err)
+		}
+
+		if text, ok := value.(string); ok {
+			output = append(output, text)
+			continue
+		}
+
+		var buffer bytes.Buffer
+		encoder := json.NewEncoder(&buffer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(value); err != nil {
+			return fmt.Errorf("failed to prepare json output: %w", err)
+		}
+		output = append(output, ansi.ColorizeJSON(strings.TrimSuffix(buffer.String(), "\n")))
+	}
+
+	if len(output) > 0 {
+		c.renderer.Output(strings.Join(output, "\n"))
+	}
+
+	return nil
 }
 
 func (i *apiCmdInputs) fromArgs(args []string, domain string) error {
@@ -205,10 +490,18 @@ func (i *apiCmdInputs) fromArgs(args []string, domain string) error {
 		return err
 	}
 
+	if i.Paginate && i.Method != http.MethodGet {
+		return fmt.Errorf("--paginate can only be used with the %s method", http.MethodGet)
+	}
+
 	if err := i.validateAndSetData(); err != nil {
 		return err
 	}
 
+	if err := i.validateAndSetJQ(); err != nil {
+		return err
+	}
+
 	return i.validateAndSetEndpoint(domain)
 }
 
@@ -257,6 +550,26 @@ func (i *apiCmdInputs) validateAndSetData() error {
 	return nil
 }
 
+func (i *apiCmdInputs) validateAndSetJQ() error {
+	if i.RawJQ == "" {
+		return nil
+	}
+
+	query, err := gojq.Parse(i.RawJQ)
+	if err != nil {
+		return fmt.Errorf("invalid jq expression: %w", err)
+	}
+
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return fmt.Errorf("invalid jq expression: %w", err)
+	}
+
+	i.JQ = code
+
+	return nil
+}
+
 func (i *apiCmdInputs) validateAndSetEndpoint(domain string) error {
 	endpoint, err := url.Parse(fmt.Sprintf("https://%s/api/v2/%s", domain, strings.Trim(i.RawURI, "/")))
 	if err != nil {
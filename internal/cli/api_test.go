@@ -2,11 +2,19 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
 	"testing"
 
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
 )
 
 func TestAPICmdInputs_FromArgs(t *testing.T) {
@@ -15,6 +23,8 @@ func TestAPICmdInputs_FromArgs(t *testing.T) {
 		name           string
 		givenArgs      []string
 		givenDataFlag  string
+		givenPaginate  bool
+		givenJQ        string
 		expectedMethod string
 		expectedURL    string
 		expectedData   any
@@ -72,6 +82,18 @@ func TestAPICmdInputs_FromArgs(t *testing.T) {
 			givenArgs:     []string{"get", "#$%^&*(#$%%^("},
 			expectedError: "invalid uri given: parse \"https://example.auth0.com/api/v2/#$%^&*(#$%%^(\": invalid URL escape \"%^&\"",
 		},
+		{
+			name:          "it fails to parse input arguments when paginating a post",
+			givenArgs:     []string{"post", "clients"},
+			givenPaginate: true,
+			expectedError: "--paginate can only be used with the GET method",
+		},
+		{
+			name:          "it fails to parse input arguments when the jq expression is invalid",
+			givenArgs:     []string{"get", "clients"},
+			givenJQ:       ".[",
+			expectedError: "invalid jq expression: unexpected EOF",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -81,7 +103,9 @@ func TestAPICmdInputs_FromArgs(t *testing.T) {
 			}
 
 			actualInputs := &apiCmdInputs{
-				RawData: testCase.givenDataFlag,
+				RawData:  testCase.givenDataFlag,
+				Paginate: testCase.givenPaginate,
+				RawJQ:    testCase.givenJQ,
 			}
 
 			err := actualInputs.fromArgs(testCase.givenArgs, testDomain)
@@ -171,3 +195,134 @@ func TestAPICmd_IsInsufficientScopeError(t *testing.T) {
 		})
 	}
 }
+
+func TestAPICmd_Paginate(t *testing.T) {
+	var testCases = []struct {
+		name           string
+		givenArgs      []string
+		givenResponses map[string]string
+		expectedOutput string
+	}{
+		{
+			name:      "it follows page and per_page pagination",
+			givenArgs: []string{"users", "-q", "per_page=2", "--paginate"},
+			givenResponses: map[string]string{
+				"page=0&per_page=2": `[{"user_id": "auth0|1"}, {"user_id": "auth0|2"}]`,
+				"page=1&per_page=2": `[{"user_id": "auth0|3"}]`,
+				"page=2&per_page=2": `[]`,
+			},
+			expectedOutput: `[{"user_id": "auth0|1"}, {"user_id": "auth0|2"}, {"user_id": "auth0|3"}]`,
+		},
+		{
+			name:      "it keeps going when the API caps the page size",
+			givenArgs: []string{"roles", "-q", "per_page=3", "-q", "include_totals=true", "--paginate"},
+			givenResponses: map[string]string{
+				"include_totals=true&page=0&per_page=3": `{"start": 0, "limit": 2, "total": 3, "roles": [{"id": "rol_1"}, {"id": "rol_2"}]}`,
+				"include_totals=true&page=1&per_page=3": `{"start": 2, "limit": 2, "total": 3, "roles": [{"id": "rol_3"}]}`,
+			},
+			expectedOutput: `[{"id": "rol_1"}, {"id": "rol_2"}, {"id": "rol_3"}]`,
+		},
+		{
+			name:      "it stops at the total of the response",
+			givenArgs: []string{"roles", "-q", "per_page=1", "-q", "include_totals=true", "--paginate"},
+			givenResponses: map[string]string{
+				"include_totals=true&page=0&per_page=1": `{"start": 0, "limit": 1, "total": 2, "roles": [{"id": "rol_1"}]}`,
+				"include_totals=true&page=1&per_page=1": `{"start": 1, "limit": 1, "total": 2, "roles": [{"id": "rol_2"}]}`,
+			},
+			expectedOutput: `[{"id": "rol_1"}, {"id": "rol_2"}]`,
+		},
+		{
+			name:      "it follows the next token of checkpoint pagination",
+			givenArgs: []string{"organizations/org_1/members", "-q", "take=1", "--paginate"},
+			givenResponses: map[string]string{
+				"take=1":          `{"members": [{"user_id": "auth0|1"}], "next": "abc"}`,
+				"from=abc&take=1": `{"members": [{"user_id": "auth0|2"}], "next": "def"}`,
+				"from=def&take=1": `{"members": []}`,
+			},
+			expectedOutput: `[{"user_id": "auth0|1"}, {"user_id": "auth0|2"}]`,
+		},
+		{
+			name:      "it follows the last log id of from and take pagination",
+			givenArgs: []string{"logs", "-q", "from=log_0", "-q", "take=2", "--paginate"},
+			givenResponses: map[string]string{
+				"from=log_0&take=2": `[{"log_id": "log_1"}, {"log_id": "log_2"}]`,
+				"from=log_2&take=2": `[{"log_id": "log_3"}]`,
+				"from=log_3&take=2": `[]`,
+			},
+			expectedOutput: `[{"log_id": "log_1"}, {"log_id": "log_2"}, {"log_id": "log_3"}]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			httpClientAPI := mock.NewMockHTTPClientAPI(ctrl)
+			expectAPIResponses(t, httpClientAPI, testCase.givenResponses)
+
+			buf := &bytes.Buffer{}
+			cli := &cli{
+				renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf},
+				api:      &auth0.API{HTTPClient: httpClientAPI},
+				tenant:   "example.auth0.com",
+			}
+
+			cmd := apiCmd(cli)
+			cmd.SetArgs(testCase.givenArgs)
+			require.NoError(t, cmd.Execute())
+
+			assert.JSONEq(t, testCase.expectedOutput, buf.String())
+		})
+	}
+}
+
+func TestAPICmd_JQ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	httpClientAPI := mock.NewMockHTTPClientAPI(ctrl)
+	expectAPIResponses(t, httpClientAPI, map[string]string{
+		"": `[{"client_id": "1", "name": "App One", "jwt_configuration": {"lifetime_in_seconds": 36000}}, {"client_id": "2", "name": "App Two"}]`,
+	})
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf},
+		api:      &auth0.API{HTTPClient: httpClientAPI},
+		tenant:   "example.auth0.com",
+	}
+
+	cmd := apiCmd(cli)
+	cmd.SetArgs([]string{"clients", "--include", "--jq", ".[].name, .[0].jwt_configuration"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, "HTTP/1.1 200 OK\nContent-Type: application/json\n\nApp One\nApp Two\n{\n  \"lifetime_in_seconds\": 36000\n}", buf.String())
+}
+
+// expectAPIResponses makes the HTTP client respond to each
+// request with the body given for its encoded query params.
+func expectAPIResponses(t *testing.T, httpClientAPI *mock.MockHTTPClientAPI, responses map[string]string) {
+	httpClientAPI.EXPECT().
+		NewRequest(gomock.Any(), http.MethodGet, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method, uri string, _ interface{}, _ ...management.RequestOption) (*http.Request, error) {
+			return http.NewRequestWithContext(ctx, method, uri, nil)
+		}).
+		Times(len(responses))
+
+	httpClientAPI.EXPECT().
+		Do(gomock.Any()).
+		DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			body, ok := responses[request.URL.RawQuery]
+			require.True(t, ok, "unexpected request: %s", request.URL)
+
+			return &http.Response{
+				Proto:      "HTTP/1.1",
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		}).
+		Times(len(responses))
+}
@@ -229,6 +229,7 @@ func appsCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(showAppCmd(cli))
 	cmd.AddCommand(updateAppCmd(cli))
 	cmd.AddCommand(deleteAppCmd(cli))
+	cmd.AddCommand(rotateAppSecretCmd(cli))
 	cmd.AddCommand(openAppCmd(cli))
 	cmd.AddCommand(appsSessionTransferCmd(cli))
 
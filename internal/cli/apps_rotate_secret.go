@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var (
+	appRotateAll = Flag{
+		Name:     "All",
+		LongForm: "all",
+		Help:     "Rotate the secret of every application that has one, except the one the CLI is authenticated with, optionally filtered with --type.",
+	}
+
+	appRotateType = Flag{
+		Name:      "Type",
+		LongForm:  "type",
+		ShortForm: "t",
+		Help: "Only rotate the secret of applications of this type, used with --all. " +
+			"Options include: native, spa, regular, m2m and resource_server.",
+	}
+
+	appRotateEnvFile = Flag{
+		Name:     "Env File",
+		LongForm: "env-file",
+		Help:     "Write the new secret to this .env file, adding or replacing the variable set with --env-var.",
+	}
+
+	appRotateEnvVar = Flag{
+		Name:     "Env Var",
+		LongForm: "env-var",
+		Help:     "Name of the variable holding the secret in the .env file.",
+	}
+)
+
+func rotateAppSecretCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		All           bool
+		Type          string
+		EnvFile       string
+		EnvVar        string
+		RevealSecrets bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rotate-secret",
+		Short: "Rotate the secret of an application",
+		Long: "Rotate the client secret of one or more applications. The previous secret stops working immediately, " +
+			"so make sure to update the applications using it.\n\n" +
+			"The new secret is only shown when using `--reveal-secrets`. Use `--env-file` to write it to a .env file " +
+			"instead, or `--reveal-secrets --json` to pipe it to a secret manager.\n\n" +
+			"To rotate the secrets of many applications at once, pass their IDs or use `--all`, optionally " +
+			"filtered with `--type`. The application used to authenticate the CLI is left out of `--all`, " +
+			"its secret is only rotated when passing its ID.",
+		Example: `  auth0 apps rotate-secret
+  auth0 apps rotate-secret <app-id>
+  auth0 apps rotate-secret <app-id> --reveal-secrets
+  auth0 apps rotate-secret <app-id> --env-file .env --force
+  auth0 apps rotate-secret <app-id> --env-file .env --env-var CLIENT_SECRET
+  auth0 apps rotate-secret <app-id> --reveal-secrets --json --force
+  auth0 apps rotate-secret <app-id> <app-id2> <app-idn>
+  auth0 apps rotate-secret --all --type m2m --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.All && len(args) > 0 {
+				return errors.New("the --all flag can't be used together with application IDs")
+			}
+			if inputs.Type != "" && !inputs.All {
+				return errors.New("the --type flag can only be used together with --all")
+			}
+
+			var ids []string
+			switch {
+			case inputs.All:
+				// Rotating the secret of the client used to authenticate the CLI would log it out.
+				tenant, _ := cli.Config.GetTenant(cli.tenant)
+
+				var err error
+				if err = ansi.Waiting(func() error {
+					ids, err = cli.appsWithSecret(cmd.Context(), apiTypeFor(inputs.Type), tenant.ClientID)
+					return err
+				}); err != nil {
+					return err
+				}
+				if len(ids) == 0 {
+					cli.renderer.Warnf("No applications with a secret to rotate.")
+					return nil
+				}
+			case len(args) == 0:
+				var id string
+				if err := appID.Pick(cmd, &id, cli.appPickerOptions()); err != nil {
+					return err
+				}
+				ids = []string{id}
+			default:
+				ids = args
+			}
+
+			if inputs.EnvFile != "" && len(ids) > 1 {
+				return errors.New("the --env-file flag can only be used when rotating the secret of a single application")
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if tenant, _ := cli.Config.GetTenant(cli.tenant); slices.Contains(ids, tenant.ClientID) {
+					cli.renderer.Warnf("Warning: You're about to rotate the secret of the client used to authenticate the CLI. If rotated, the CLI will cease to operate once the access token has expired.")
+				}
+				message := fmt.Sprintf("Are you sure you want to rotate the secret of %d application(s)? The previous secret will stop working immediately.", len(ids))
+				if confirmed := prompt.Confirm(message); !confirmed {
+					return nil
+				}
+			}
+
+			// Secrets that were rotated are rendered even if others failed, as the previous ones no longer work.
+			var clients []*management.Client
+			rotateErr := ansi.ProgressBar("Rotating Secret(s)", ids, func(_ int, id string) error {
+				client, err := cli.api.Client.RotateSecret(cmd.Context(), id)
+				if err != nil {
+					return fmt.Errorf("failed to rotate the secret of application with ID %q: %w", id, err)
+				}
+				clients = append(clients, client)
+				return nil
+			})
+			if len(clients) == 0 {
+				return rotateErr
+			}
+
+			if inputs.EnvFile != "" {
+				if err := writeEnvFileVariable(inputs.EnvFile, inputs.EnvVar, clients[0].GetClientSecret()); err != nil {
+					return fmt.Errorf("failed to write the secret to %q: %w", inputs.EnvFile, err)
+				}
+				cli.renderer.Infof("Wrote the new secret to %s as %s", ansi.Bold(inputs.EnvFile), ansi.Bold(inputs.EnvVar))
+			}
+
+			cli.renderer.ApplicationSecretsRotated(clients, inputs.RevealSecrets)
+
+			return rotateErr
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	appRotateAll.RegisterBool(cmd, &inputs.All, false)
+	appRotateType.RegisterString(cmd, &inputs.Type, "")
+	appRotateEnvFile.RegisterString(cmd, &inputs.EnvFile, "")
+	appRotateEnvVar.RegisterString(cmd, &inputs.EnvVar, "AUTH0_CLIENT_SECRET")
+	revealSecrets.RegisterBool(cmd, &inputs.RevealSecrets, false)
+
+	return cmd
+}
+
+// appsWithSecret returns the IDs of the applications authenticating with a
+// client secret, optionally filtered by application type, leaving out the
+// excluded application.
+func (c *cli) appsWithSecret(ctx context.Context, appType, excludedID string) ([]string, error) {
+	var ids []string
+
+	var page int
+	for {
+		list, err := c.api.Client.List(
+			ctx,
+			management.Page(page),
+			management.Parameter("is_global", "false"),
+			management.IncludeFields("client_id", "app_type", "token_endpoint_auth_method"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list applications: %w", err)
+		}
+
+		for _, client := range list.Clients {
+			if client.GetTokenEndpointAuthMethod() == "none" {
+				continue
+			}
+			if appType != "" && client.GetAppType() != appType {
+				continue
+			}
+			if excludedID != "" && client.GetClientID() == excludedID {
+				continue
+			}
+			ids = append(ids, client.GetClientID())
+		}
+
+		if !list.HasNext() {
+			break
+		}
+
+		page++
+	}
+
+	return ids, nil
+}
+
+// writeEnvFileVariable sets the variable in the .env file, replacing
+// any previous value and creating the file if it doesn't exist.
+func writeEnvFileVariable(path, name, value string) error {
+	content, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	line := fmt.Sprintf("%s=%q", name, value)
+
+	var lines []string
+	if len(content) > 0 {
+		lines = strings.Split(strings.TrimRight(string(content), "\n"), "\n")
+	}
+
+	replaced := false
+	for i, existing := range lines {
+		trimmed := strings.TrimPrefix(strings.TrimSpace(existing), "export ")
+		if strings.HasPrefix(trimmed, name+"=") {
+			lines[i] = line
+			replaced = true
+		}
+	}
+	if !replaced {
+		lines = append(lines, line)
+	}
+
+	return os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600)
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestAppsRotateSecretCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientAPI := mock.NewMockClientAPI(ctrl)
+	clientAPI.EXPECT().
+		List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&management.ClientList{
+			Clients: []*management.Client{
+				{ClientID: auth0.String("spa-id"), AppType: auth0.String("spa"), TokenEndpointAuthMethod: auth0.String("none")},
+				{ClientID: auth0.String("m2m-id"), AppType: auth0.String("non_interactive"), TokenEndpointAuthMethod: auth0.String("client_secret_post")},
+				{ClientID: auth0.String("web-id"), AppType: auth0.String("regular_web"), TokenEndpointAuthMethod: auth0.String("client_secret_post")},
+			},
+		}, nil)
+	clientAPI.EXPECT().
+		RotateSecret(gomock.Any(), "m2m-id").
+		Return(&management.Client{
+			Name:         auth0.String("some-name"),
+			ClientID:     auth0.String("m2m-id"),
+			AppType:      auth0.String("non_interactive"),
+			ClientSecret: auth0.String("new-secret"),
+		}, nil)
+
+	stdout := &bytes.Buffer{}
+	envFile := filepath.Join(t.TempDir(), ".env")
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: stdout},
+		api:      &auth0.API{Client: clientAPI},
+	}
+
+	cmd := rotateAppSecretCmd(cli)
+	cmd.SetArgs([]string{"--all", "--type", "m2m", "--force", "--reveal-secrets", "--env-file", envFile})
+	require.NoError(t, cmd.Execute())
+
+	expectTable(t, stdout.String(),
+		[]string{"CLIENT ID", "NAME", "TYPE", "CLIENT SECRET", "RESOURCE SERVER"},
+		[][]string{
+			{"m2m-id", "some-name", "Machine to Machine", "new-secret", ""},
+		},
+	)
+
+	content, err := os.ReadFile(envFile)
+	require.NoError(t, err)
+	assert.Equal(t, "AUTH0_CLIENT_SECRET=\"new-secret\"\n", string(content))
+}
+
+func TestAppsWithSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientAPI := mock.NewMockClientAPI(ctrl)
+	clientAPI.EXPECT().
+		List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&management.ClientList{
+			Clients: []*management.Client{
+				{ClientID: auth0.String("cli-id"), AppType: auth0.String("non_interactive"), TokenEndpointAuthMethod: auth0.String("client_secret_post")},
+				{ClientID: auth0.String("m2m-id"), AppType: auth0.String("non_interactive"), TokenEndpointAuthMethod: auth0.String("client_secret_post")},
+			},
+		}, nil)
+
+	cli := &cli{api: &auth0.API{Client: clientAPI}}
+
+	// The application used to authenticate the CLI is left out.
+	ids, err := cli.appsWithSecret(context.Background(), "", "cli-id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"m2m-id"}, ids)
+}
+
+func TestWriteEnvFileVariable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(path, []byte("AUTH0_DOMAIN=example.auth0.com\nexport AUTH0_CLIENT_SECRET=old\n"), 0600))
+
+	require.NoError(t, writeEnvFileVariable(path, "AUTH0_CLIENT_SECRET", "new"))
+	require.NoError(t, writeEnvFileVariable(path, "OTHER_SECRET", "other"))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "AUTH0_DOMAIN=example.auth0.com\nAUTH0_CLIENT_SECRET=\"new\"\nOTHER_SECRET=\"other\"\n", string(content))
+}
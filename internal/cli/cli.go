@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -14,6 +15,7 @@ import (
 	"github.com/auth0/auth0-cli/internal/auth0"
 	"github.com/auth0/auth0-cli/internal/config"
 	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/har"
 	"github.com/auth0/auth0-cli/internal/iostream"
 	"github.com/auth0/auth0-cli/internal/prompt"
 )
@@ -48,17 +50,31 @@ type cli struct {
 	force               bool
 	noInput             bool
 	noColor             bool
+	maxRetries          int
+	record              string
+	replay              string
+	profile             string
 	agentMode           bool
 	detectedAgent       string
 	executedCommandPath string
 
 	Config config.Config
+
+	// ActiveProfile holds the defaults of the profile in use, see applyProfile.
+	activeProfile config.Profile
+
+	// Transport sends the Management API requests, see managementTransport.
+	transport http.RoundTripper
 }
 
 // setupWithAuthentication will fetch the tenant from the config.json
 // and regenerate its access token if needed. The access token will
 // then be used to configure an instance of the Auth0 Management SDK.
 func (c *cli) setupWithAuthentication(ctx context.Context) error {
+	if c.replay != "" {
+		return c.setupWithReplay()
+	}
+
 	// Validate that we have at least one tenant that we can use.
 	if err := c.Config.Validate(); err != nil {
 		return err
@@ -70,12 +86,100 @@ func (c *cli) setupWithAuthentication(ctx context.Context) error {
 		c.tenant = c.Config.DefaultTenant
 	}
 
-	// Get the tenant from the config.
-	tenant, err := c.Config.GetTenant(c.tenant)
+	tenant, err := c.authenticatedTenant(ctx, c.tenant)
 	if err != nil {
 		return err
 	}
 
+	return c.initializeManagementClients(tenant.Domain, tenant.GetAccessToken())
+}
+
+// setupWithReplay configures the Management API clients to serve the responses
+// recorded in the --replay file, so that commands run offline and without
+// authenticating. The tenant defaults to the one of the recording.
+func (c *cli) setupWithReplay() error {
+	transport, err := c.managementTransport()
+	if err != nil {
+		return err
+	}
+
+	if replayer, ok := transport.(*har.Replayer); ok && c.tenant == "" {
+		c.tenant = replayer.Host()
+		c.renderer.Tenant = c.tenant
+	}
+
+	if c.tenant == "" {
+		return fmt.Errorf("failed to replay %s: it holds no requests", c.replay)
+	}
+
+	return c.initializeManagementClients(c.tenant, "")
+}
+
+func (c *cli) initializeManagementClients(tenantDomain, accessToken string) error {
+	transport, err := c.managementTransport()
+	if err != nil {
+		return err
+	}
+
+	invokerMetadata := c.invokerMetadataHeaderValue()
+	retries := c.retryPolicy()
+
+	api, err := initializeManagementClient(tenantDomain, accessToken, invokerMetadata, retries, transport)
+	if err != nil {
+		return err
+	}
+
+	apiv3, err := initializeManagementClientV3(tenantDomain, accessToken, invokerMetadata, retries, transport)
+	if err != nil {
+		return err
+	}
+
+	c.api = auth0.NewAPI(api)
+	c.apiv3 = auth0.NewAPIV3(apiv3)
+	return nil
+}
+
+// managementTransport returns the transport sending the Management API requests. It
+// records them with --record, or serves their responses from a recording with --replay.
+func (c *cli) managementTransport() (http.RoundTripper, error) {
+	if c.transport != nil {
+		return c.transport, nil
+	}
+
+	switch {
+	case c.replay != "":
+		replayer, err := har.NewReplayer(c.replay)
+		if err != nil {
+			return nil, err
+		}
+		c.transport = replayer
+	case c.record != "":
+		c.transport = har.NewRecorder(c.record, http.DefaultTransport)
+	default:
+		c.transport = http.DefaultTransport
+	}
+
+	return c.transport, nil
+}
+
+// closeTransport writes the --record file, once the command has sent its requests.
+func (c *cli) closeTransport() error {
+	if recorder, ok := c.transport.(*har.Recorder); ok {
+		return recorder.Close()
+	}
+
+	return nil
+}
+
+// authenticatedTenant fetches the tenant from the config.json
+// and regenerates its access token if needed.
+func (c *cli) authenticatedTenant(ctx context.Context, name string) (config.Tenant, error) {
+	// Get the tenant from the config.
+	tenant, err := c.Config.GetTenant(name)
+	if err != nil {
+		return config.Tenant{}, err
+	}
+
 	// Check authentication status.
 	err = tenant.CheckAuthenticationStatus()
 	var scopesErr config.ErrTokenMissingRequiredScopes
@@ -83,7 +187,7 @@ func (c *cli) setupWithAuthentication(ctx context.Context) error {
 		c.renderer.Warnf("Required scopes have changed (missing: %s). Please log in to re-authorize the CLI.\n", strings.Join(scopesErr.MissingScopes, ", "))
 		tenant, err = RunLoginAsUser(ctx, c, scopesErr.MissingScopes, "")
 		if err != nil {
-			return err
+			return config.Tenant{}, err
 		}
 	}
 
@@ -94,7 +198,7 @@ func (c *cli) setupWithAuthentication(ctx context.Context) error {
 
 			// In --no-input mode, fail immediately instead of hanging on an interactive prompt.
 			if c.noInput {
-				return fmt.Errorf(
+				return config.Tenant{}, fmt.Errorf(
 					"auth token expired and --no-input is set; run 'auth0 login' to re-authenticate",
 				)
 			}
@@ -109,7 +213,7 @@ func (c *cli) setupWithAuthentication(ctx context.Context) error {
 
 			tenant, err = RunLoginAsUser(ctx, c, tenant.GetExtraRequestedScopes(), tenantDomain)
 			if err != nil {
-				return err
+				return config.Tenant{}, err
 			}
 		} else if err := tenant.RegenerateAccessToken(ctx); err != nil {
 			errorMessage := fmt.Errorf(
@@ -121,36 +225,47 @@ func (c *cli) setupWithAuthentication(ctx context.Context) error {
 				err,
 				ansi.Bold("auth0 login --domain <tenant-domain> --client-id <client-id> --client-secret <client-secret>"),
 			)
-			return errorMessage
+			return config.Tenant{}, errorMessage
 		}
 
 		if err := c.Config.AddTenant(tenant); err != nil {
-			return err
+			return config.Tenant{}, err
 		}
 	}
 
 	if errors.Is(err, config.ErrMalformedToken) {
-		return fmt.Errorf("authentication token is corrupted, please run: %s\n\n%s",
+		return config.Tenant{}, fmt.Errorf("authentication token is corrupted, please run: %s\n\n%s",
 			ansi.Cyan("auth0 logout && auth0 login"),
 			ansi.Yellow("Note: Token handling was enhanced in v1.18.0+ to prevent malformed tokens."),
 		)
 	}
 
-	invokerMetadata := c.invokerMetadataHeaderValue()
+	return tenant, nil
+}
 
-	api, err := initializeManagementClient(tenant.Domain, tenant.GetAccessToken(), invokerMetadata)
+// tenantAPI returns a client of the Management API for the given
+// tenant, which might not be the one the command is running against.
+func (c *cli) tenantAPI(ctx context.Context, name string) (*auth0.API, error) {
+	transport, err := c.managementTransport()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	apiv3, err := initializeManagementClientV3(tenant.Domain, tenant.GetAccessToken(), invokerMetadata)
+	domain, accessToken := name, ""
+	if c.replay == "" {
+		tenant, err := c.authenticatedTenant(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		domain, accessToken = tenant.Domain, tenant.GetAccessToken()
+	}
+
+	api, err := initializeManagementClient(domain, accessToken, c.invokerMetadataHeaderValue(), c.retryPolicy(), transport)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	c.api = auth0.NewAPI(api)
-	c.apiv3 = auth0.NewAPIV3(apiv3)
-	return nil
+	return auth0.NewAPI(api), nil
 }
 
 func (c *cli) configureRenderer() {
@@ -0,0 +1,950 @@
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+const (
+	connectionCategoryDatabase     = "database"
+	connectionCategoryPasswordless = "passwordless"
+	connectionCategorySocial       = "social"
+	connectionCategoryEnterprise   = "enterprise"
+)
+
+var (
+	connectionStrategyOptions = []string{
+		management.ConnectionStrategyAuth0,
+		management.ConnectionStrategyEmail,
+		management.ConnectionStrategySMS,
+		management.ConnectionStrategyGoogleOAuth2,
+		management.ConnectionStrategyGitHub,
+		management.ConnectionStrategyFacebook,
+		management.ConnectionStrategyApple,
+		management.ConnectionStrategyLinkedin,
+		management.ConnectionStrategyWindowsLive,
+		management.ConnectionStrategySAML,
+		management.ConnectionStrategyOIDC,
+		management.ConnectionStrategyOkta,
+		management.ConnectionStrategyAzureAD,
+		management.ConnectionStrategyADFS,
+	}
+
+	connectionEnterpriseStrategies = []string{
+		management.ConnectionStrategyAD,
+		management.ConnectionStrategyADFS,
+		management.ConnectionStrategyAzureAD,
+		management.ConnectionStrategyGoogleApps,
+		management.ConnectionStrategyOIDC,
+		management.ConnectionStrategyOkta,
+		management.ConnectionStrategyPingFederate,
+		management.ConnectionStrategySAML,
+		"auth0-oidc",
+		"ip",
+		"office365",
+		"sharepoint",
+	}
+
+	connectionPasswordPolicyOptions = []string{"none", "low", "fair", "good", "excellent"}
+
+	connID = Argument{
+		Name: "Connection ID",
+		Help: "ID of the connection.",
+	}
+
+	connectionName = Flag{
+		Name:       "Name",
+		LongForm:   "name",
+		ShortForm:  "n",
+		Help:       "Name of the connection.",
+		IsRequired: true,
+	}
+
+	connectionStrategy = Flag{
+		Name:       "Strategy",
+		LongForm:   "strategy",
+		ShortForm:  "s",
+		Help:       "Identity provider of the connection, e.g. auth0, email, sms, google-oauth2, samlp, oidc, okta or waad.",
+		IsRequired: true,
+	}
+
+	connectionDisplayName = Flag{
+		Name:         "Display Name",
+		LongForm:     "display-name",
+		ShortForm:    "d",
+		Help:         "Name used in the login screen.",
+		AlwaysPrompt: true,
+	}
+
+	connectionOptions = Flag{
+		Name:      "Options",
+		LongForm:  "options",
+		ShortForm: "o",
+		Help:      "Strategy specific options of the connection, formatted as JSON.",
+	}
+
+	connectionPasswordPolicy = Flag{
+		Name:     "Password Policy",
+		LongForm: "password-policy",
+		Help: "Password strength required by a database connection. " +
+			"Possible values: none, low, fair, good or excellent.",
+	}
+
+	connectionIdPClientID = Flag{
+		Name:     "Identity Provider Client ID",
+		LongForm: "client-id",
+		Help: "Client ID of the application registered with the identity provider. " +
+			"Leave empty on social connections to use the Auth0 development keys.",
+	}
+
+	connectionIdPClientSecret = Flag{
+		Name:     "Identity Provider Client Secret",
+		LongForm: "client-secret",
+		Help:     "Client secret of the application registered with the identity provider.",
+	}
+
+	connectionDomain = Flag{
+		Name:     "Domain",
+		LongForm: "domain",
+		Help:     "Domain of the identity provider. Used by okta and waad connections.",
+	}
+
+	connectionDiscoveryURL = Flag{
+		Name:     "Discovery URL",
+		LongForm: "discovery-url",
+		Help:     "OpenID Connect discovery URL of the identity provider. Used by oidc connections.",
+	}
+
+	connectionSignInURL = Flag{
+		Name:     "Sign In URL",
+		LongForm: "sign-in-url",
+		Help:     "Sign in URL of the SAML identity provider. Used by samlp connections.",
+	}
+
+	connectionSigningCert = Flag{
+		Name:     "Signing Certificate",
+		LongForm: "signing-cert",
+		Help:     "Path to the X.509 signing certificate (PEM or CER) of the SAML identity provider. Used by samlp connections.",
+	}
+
+	connectionMetadataURL = Flag{
+		Name:     "ADFS Metadata URL",
+		LongForm: "metadata-url",
+		Help:     "Federation metadata URL of the ADFS server. Used by adfs connections.",
+	}
+
+	connectionNumber = Flag{
+		Name:      "Number",
+		LongForm:  "number",
+		ShortForm: "n",
+		Help:      "Number of connections to retrieve. Minimum 1, maximum 1000.",
+	}
+
+	connectionStrategyFilter = Flag{
+		Name:      "Strategy",
+		LongForm:  "strategy",
+		ShortForm: "s",
+		Help:      "Only list connections using the given strategy.",
+	}
+
+	connectionClients = Flag{
+		Name:       "Clients",
+		LongForm:   "clients",
+		ShortForm:  "c",
+		Help:       "IDs of the applications.",
+		IsRequired: true,
+	}
+)
+
+// connectionStrategyInputs holds the strategy specific
+// settings that can be supplied through flags or prompts.
+type connectionStrategyInputs struct {
+	PasswordPolicy string
+	ClientID       string
+	ClientSecret   string
+	Domain         string
+	DiscoveryURL   string
+	SignInURL      string
+	SigningCert    string
+	MetadataURL    string
+}
+
+func connectionsCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "connections",
+		Aliases: []string{"connection"},
+		Short:   "Manage resources for connections",
+		Long: "Manage resources for database, passwordless, social and enterprise connections. " +
+			"To learn more, read [Connections](https://auth0.com/docs/authenticate/identity-providers).",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listConnectionsCmd(cli))
+	cmd.AddCommand(showConnectionCmd(cli))
+	cmd.AddCommand(createConnectionCmd(cli))
+	cmd.AddCommand(updateConnectionCmd(cli))
+	cmd.AddCommand(deleteConnectionCmd(cli))
+	cmd.AddCommand(openConnectionCmd(cli))
+	cmd.AddCommand(enabledClientsConnectionCmd(cli))
+
+	return cmd
+}
+
+func listConnectionsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Strategy string
+		Number   int
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List your connections",
+		Long:    "List your existing connections. To create one, run: `auth0 connections create`.",
+		Example: `  auth0 connections list
+  auth0 connections ls
+  auth0 connections ls --strategy auth0
+  auth0 connections ls --number 100
+  auth0 connections ls -n 100 --json
+  auth0 connections ls -n 100 --json-compact
+  auth0 connections ls --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Number < 1 || inputs.Number > 1000 {
+				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
+			}
+
+			list, err := getWithPagination(
+				inputs.Number,
+				func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+					if inputs.Strategy != "" {
+						opts = append(opts, management.Parameter("strategy", inputs.Strategy))
+					}
+
+					connectionList, err := cli.api.Connection.List(cmd.Context(), opts...)
+					if err != nil {
+						return nil, false, err
+					}
+					if connectionList == nil {
+						return result, false, nil
+					}
+
+					for _, connection := range connectionList.Connections {
+						result = append(result, connection)
+					}
+
+					return result, connectionList.HasNext(), nil
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to list connections: %w", err)
+			}
+
+			var connections []*management.Connection
+			for _, item := range list {
+				connections = append(connections, item.(*management.Connection))
+			}
+
+			cli.renderer.ConnectionList(connections)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	connectionStrategyFilter.RegisterString(cmd, &inputs.Strategy, "")
+	connectionNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+
+	return cmd
+}
+
+func showConnectionCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show a connection",
+		Long:  "Display information about a connection.",
+		Example: `  auth0 connections show
+  auth0 connections show <connection-id>
+  auth0 connections show <connection-id> --json
+  auth0 connections show <connection-id> --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := connID.Pick(cmd, &inputs.ID, cli.connectionPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var connection *management.Connection
+			if err := ansi.Waiting(func() (err error) {
+				connection, err = cli.api.Connection.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read connection with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.ConnectionShow(connection)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+
+	return cmd
+}
+
+func createConnectionCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Name        string
+		Strategy    string
+		DisplayName string
+		Options     string
+		connectionStrategyInputs
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Args:  cobra.NoArgs,
+		Short: "Create a new connection",
+		Long: "Create a new connection.\n\n" +
+			"To create interactively, use `auth0 connections create` with no arguments. " +
+			"The prompts adapt to the selected strategy.\n\n" +
+			"To create non-interactively, supply the name, strategy and any strategy specific settings through the flags. " +
+			"Settings not covered by a flag can be passed as JSON through `--options`.",
+		Example: `  auth0 connections create
+  auth0 connections create --name my-db --strategy auth0 --password-policy good
+  auth0 connections create -n my-passwordless -s email
+  auth0 connections create -n my-google -s google-oauth2 --client-id <client-id> --client-secret <client-secret>
+  auth0 connections create -n my-oidc -s oidc --client-id <client-id> --discovery-url https://idp.example.com/.well-known/openid-configuration
+  auth0 connections create -n my-saml -s samlp --sign-in-url https://idp.example.com/saml --signing-cert ./idp.pem
+  auth0 connections create -n my-db -s auth0 --options '{"requires_username": true}' --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := connectionName.Ask(cmd, &inputs.Name, nil); err != nil {
+				return err
+			}
+
+			if err := connectionStrategy.Select(cmd, &inputs.Strategy, connectionStrategyOptions, nil); err != nil {
+				return err
+			}
+
+			if err := connectionDisplayName.Ask(cmd, &inputs.DisplayName, nil); err != nil {
+				return err
+			}
+
+			if err := askConnectionStrategyInputs(cmd, inputs.Strategy, &inputs.connectionStrategyInputs); err != nil {
+				return err
+			}
+
+			options := map[string]interface{}{}
+			if inputs.Options != "" {
+				if err := json.Unmarshal([]byte(inputs.Options), &options); err != nil {
+					return fmt.Errorf("invalid JSON input for options: %w", err)
+				}
+			}
+
+			if err := inputs.connectionStrategyInputs.applyTo(inputs.Strategy, options); err != nil {
+				return err
+			}
+
+			connection := &management.Connection{
+				Name:     &inputs.Name,
+				Strategy: &inputs.Strategy,
+			}
+			if inputs.DisplayName != "" {
+				connection.DisplayName = &inputs.DisplayName
+			}
+			if len(options) > 0 {
+				connection.Options = options
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Connection.Create(cmd.Context(), connection)
+			}); err != nil {
+				return fmt.Errorf("failed to create connection: %w", err)
+			}
+
+			cli.renderer.ConnectionCreate(connection)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	connectionName.RegisterString(cmd, &inputs.Name, "")
+	connectionStrategy.RegisterString(cmd, &inputs.Strategy, "")
+	connectionDisplayName.RegisterString(cmd, &inputs.DisplayName, "")
+	connectionOptions.RegisterString(cmd, &inputs.Options, "")
+	registerConnectionStrategyFlags(cmd, &inputs.connectionStrategyInputs)
+
+	return cmd
+}
+
+func updateConnectionCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID          string
+		DisplayName string
+		Options     string
+		connectionStrategyInputs
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Update a connection",
+		Long: "Update a connection.\n\n" +
+			"To update interactively, use `auth0 connections update` with no arguments. " +
+			"The connection options will be opened as JSON in your default editor.\n\n" +
+			"To update non-interactively, supply the connection id and the settings to change through the flags. " +
+			"Options passed through `--options` replace the existing options.",
+		Example: `  auth0 connections update
+  auth0 connections update <connection-id> --display-name "My Connection"
+  auth0 connections update <connection-id> --password-policy excellent
+  auth0 connections update <connection-id> --client-id <client-id> --client-secret <client-secret>
+  auth0 connections update <connection-id> --options '{"brute_force_protection": true}'
+  auth0 connections update <connection-id> -d "My Connection" --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := connID.Pick(cmd, &inputs.ID, cli.connectionPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var current *management.Connection
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.api.Connection.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read connection with ID %q: %w", inputs.ID, err)
+			}
+
+			if err := connectionDisplayName.AskU(cmd, &inputs.DisplayName, current.DisplayName); err != nil {
+				return err
+			}
+
+			currentOptions, err := json.MarshalIndent(current.Options, "", "    ")
+			if err != nil {
+				return fmt.Errorf("failed to serialize the options of connection with ID %q: %w", inputs.ID, err)
+			}
+
+			if noLocalFlagSet(cmd) {
+				if err := connectionOptions.OpenEditorU(
+					cmd,
+					&inputs.Options,
+					string(currentOptions),
+					current.GetName()+".*.json",
+				); err != nil {
+					return fmt.Errorf("failed to capture input from the editor: %w", err)
+				}
+			}
+
+			var options map[string]interface{}
+			optionsJSON := inputs.Options
+			if optionsJSON == "" {
+				optionsJSON = string(currentOptions)
+			}
+			if err := json.Unmarshal([]byte(optionsJSON), &options); err != nil {
+				return fmt.Errorf("invalid JSON input for options: %w", err)
+			}
+			if options == nil {
+				options = map[string]interface{}{}
+			}
+
+			if err := inputs.connectionStrategyInputs.applyTo(current.GetStrategy(), options); err != nil {
+				return err
+			}
+
+			updatedConnection := &management.Connection{}
+			if inputs.DisplayName != "" {
+				updatedConnection.DisplayName = &inputs.DisplayName
+			}
+			if inputs.Options != "" || connectionStrategyFlagsSet(cmd) {
+				updatedConnection.Options = options
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Connection.Update(cmd.Context(), inputs.ID, updatedConnection)
+			}); err != nil {
+				return fmt.Errorf("failed to update connection with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.ConnectionUpdate(updatedConnection)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	connectionDisplayName.RegisterStringU(cmd, &inputs.DisplayName, "")
+	connectionOptions.RegisterStringU(cmd, &inputs.Options, "")
+	registerConnectionStrategyFlags(cmd, &inputs.connectionStrategyInputs)
+
+	return cmd
+}
+
+func deleteConnectionCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a connection",
+		Long: "Delete a connection. All users authenticating through the connection will be deleted as well.\n\n" +
+			"To delete interactively, use `auth0 connections delete`.\n\n" +
+			"To delete non-interactively, supply the connection id and the `--force` flag to skip confirmation.",
+		Example: `  auth0 connections delete
+  auth0 connections rm
+  auth0 connections delete <connection-id>
+  auth0 connections delete <connection-id> --force
+  auth0 connections delete <connection-id> <connection-id2> <connection-idn>
+  auth0 connections delete <connection-id> <connection-id2> <connection-idn> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var ids []string
+			if len(args) == 0 {
+				if err := connID.PickMany(cmd, &ids, cli.connectionPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				ids = args
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			return ansi.ProgressBar("Deleting Connection(s)", ids, func(_ int, id string) error {
+				if id != "" {
+					if _, err := cli.api.Connection.Read(cmd.Context(), id); err != nil {
+						return fmt.Errorf("failed to delete connection with ID %q: %w", id, err)
+					}
+
+					if err := cli.api.Connection.Delete(cmd.Context(), id); err != nil {
+						return fmt.Errorf("failed to delete connection with ID %q: %w", id, err)
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func openConnectionCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "open",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Open the settings page of a connection",
+		Long:  "Open a connection's settings page in the Auth0 Dashboard.",
+		Example: `  auth0 connections open
+  auth0 connections open <connection-id>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := connID.Pick(cmd, &inputs.ID, cli.connectionPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var connection *management.Connection
+			if err := ansi.Waiting(func() (err error) {
+				connection, err = cli.api.Connection.Read(cmd.Context(), inputs.ID, management.IncludeFields("id", "strategy"))
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read connection with ID %q: %w", inputs.ID, err)
+			}
+
+			openManageURL(cli, cli.Config.DefaultTenant, formatConnectionSettingsPath(inputs.ID, connection.GetStrategy()))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func enabledClientsConnectionCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enabled-clients",
+		Short: "Manage the applications enabled for a connection",
+		Long:  "Manage the applications that can use a connection to authenticate users.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listEnabledClientsConnectionCmd(cli))
+	cmd.AddCommand(addEnabledClientsConnectionCmd(cli))
+	cmd.AddCommand(removeEnabledClientsConnectionCmd(cli))
+
+	return cmd
+}
+
+func listEnabledClientsConnectionCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "List the applications enabled for a connection",
+		Long:    "List the applications enabled for a connection.",
+		Example: `  auth0 connections enabled-clients list
+  auth0 connections enabled-clients ls <connection-id>
+  auth0 connections enabled-clients ls <connection-id> --json
+  auth0 connections enabled-clients ls <connection-id> --json-compact
+  auth0 connections enabled-clients ls <connection-id> --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := connID.Pick(cmd, &inputs.ID, cli.connectionPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var clients []management.ConnectionEnabledClient
+			if err := ansi.Waiting(func() (err error) {
+				clients, err = getConnectionEnabledClients(cmd.Context(), cli.api, inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to list enabled clients of connection with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.ConnectionEnabledClientList(clients)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	return cmd
+}
+
+func addEnabledClientsConnectionCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID      string
+		Clients []string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Enable applications for a connection",
+		Long:  "Enable one or more applications to use a connection.",
+		Example: `  auth0 connections enabled-clients add
+  auth0 connections enabled-clients add <connection-id>
+  auth0 connections enabled-clients add <connection-id> --clients <client-id1>,<client-id2>
+  auth0 connections enabled-clients add <connection-id> -c <client-id1> -c <client-id2> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cli.setConnectionEnabledClients(cmd, args, &inputs.ID, &inputs.Clients, true)
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	connectionClients.RegisterStringSlice(cmd, &inputs.Clients, nil)
+
+	return cmd
+}
+
+func removeEnabledClientsConnectionCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID      string
+		Clients []string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove",
+		Aliases: []string{"rm"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Disable applications for a connection",
+		Long:    "Disable one or more applications from using a connection.",
+		Example: `  auth0 connections enabled-clients remove
+  auth0 connections enabled-clients rm <connection-id>
+  auth0 connections enabled-clients remove <connection-id> --clients <client-id1>,<client-id2>
+  auth0 connections enabled-clients rm <connection-id> -c <client-id1> -c <client-id2> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cli.setConnectionEnabledClients(cmd, args, &inputs.ID, &inputs.Clients, false)
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	connectionClients.RegisterStringSlice(cmd, &inputs.Clients, nil)
+
+	return cmd
+}
+
+func (c *cli) setConnectionEnabledClients(cmd *cobra.Command, args []string, id *string, clientIDs *[]string, enabled bool) error {
+	if len(args) == 0 {
+		if err := connID.Pick(cmd, id, c.connectionPickerOptions); err != nil {
+			return err
+		}
+	} else {
+		*id = args[0]
+	}
+
+	if err := connectionClients.PickMany(cmd, clientIDs, c.appPickerOptions()); err != nil {
+		return err
+	}
+
+	if len(*clientIDs) == 0 {
+		return errors.New("at least one client ID is required")
+	}
+
+	payload := make([]management.ConnectionEnabledClient, 0, len(*clientIDs))
+	for _, clientID := range *clientIDs {
+		payload = append(payload, management.ConnectionEnabledClient{
+			ClientID: &clientID,
+			Status:   &enabled,
+		})
+	}
+
+	var clients []management.ConnectionEnabledClient
+	if err := ansi.Waiting(func() (err error) {
+		if err = c.api.Connection.UpdateEnabledClients(cmd.Context(), *id, payload); err != nil {
+			return err
+		}
+
+		clients, err = getConnectionEnabledClients(cmd.Context(), c.api, *id)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to update enabled clients of connection with ID %q: %w", *id, err)
+	}
+
+	c.renderer.ConnectionEnabledClientList(clients)
+
+	return nil
+}
+
+func getConnectionEnabledClients(ctx context.Context, api *auth0.API, id string) ([]management.ConnectionEnabledClient, error) {
+	var (
+		clients []management.ConnectionEnabledClient
+		from    string
+	)
+
+	for {
+		opts := []management.RequestOption{management.Take(100)}
+		if from != "" {
+			opts = append(opts, management.From(from))
+		}
+
+		list, err := api.Connection.ReadEnabledClients(ctx, id, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if list.Clients != nil {
+			clients = append(clients, *list.Clients...)
+		}
+
+		if list.Next == "" {
+			return clients, nil
+		}
+
+		from = list.Next
+	}
+}
+
+func registerConnectionStrategyFlags(cmd *cobra.Command, inputs *connectionStrategyInputs) {
+	connectionPasswordPolicy.RegisterString(cmd, &inputs.PasswordPolicy, "")
+	connectionIdPClientID.RegisterString(cmd, &inputs.ClientID, "")
+	connectionIdPClientSecret.RegisterString(cmd, &inputs.ClientSecret, "")
+	connectionDomain.RegisterString(cmd, &inputs.Domain, "")
+	connectionDiscoveryURL.RegisterString(cmd, &inputs.DiscoveryURL, "")
+	connectionSignInURL.RegisterString(cmd, &inputs.SignInURL, "")
+	connectionSigningCert.RegisterString(cmd, &inputs.SigningCert, "")
+	connectionMetadataURL.RegisterString(cmd, &inputs.MetadataURL, "")
+}
+
+func connectionStrategyFlagsSet(cmd *cobra.Command) bool {
+	for _, f := range []*Flag{
+		&connectionPasswordPolicy,
+		&connectionIdPClientID,
+		&connectionIdPClientSecret,
+		&connectionDomain,
+		&connectionDiscoveryURL,
+		&connectionSignInURL,
+		&connectionSigningCert,
+		&connectionMetadataURL,
+	} {
+		if f.IsSet(cmd) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// askConnectionStrategyInputs prompts only for the settings
+// that are relevant to the strategy of the connection.
+func askConnectionStrategyInputs(cmd *cobra.Command, strategy string, inputs *connectionStrategyInputs) error {
+	switch connectionCategoryFor(strategy) {
+	case connectionCategoryDatabase:
+		defaultPolicy := "good"
+		return connectionPasswordPolicy.Select(cmd, &inputs.PasswordPolicy, connectionPasswordPolicyOptions, &defaultPolicy)
+	case connectionCategorySocial:
+		if err := connectionIdPClientID.Ask(cmd, &inputs.ClientID, nil); err != nil {
+			return err
+		}
+		if inputs.ClientID == "" {
+			return nil
+		}
+		return connectionIdPClientSecret.Ask(cmd, &inputs.ClientSecret, nil)
+	}
+
+	switch strategy {
+	case management.ConnectionStrategyOIDC, management.ConnectionStrategyOkta, management.ConnectionStrategyAzureAD:
+		if err := connectionIdPClientID.Ask(cmd, &inputs.ClientID, nil); err != nil {
+			return err
+		}
+		if err := connectionIdPClientSecret.Ask(cmd, &inputs.ClientSecret, nil); err != nil {
+			return err
+		}
+		if strategy == management.ConnectionStrategyOIDC {
+			return connectionDiscoveryURL.Ask(cmd, &inputs.DiscoveryURL, nil)
+		}
+		return connectionDomain.Ask(cmd, &inputs.Domain, nil)
+	case management.ConnectionStrategySAML:
+		if err := connectionSignInURL.Ask(cmd, &inputs.SignInURL, nil); err != nil {
+			return err
+		}
+		return connectionSigningCert.Ask(cmd, &inputs.SigningCert, nil)
+	case management.ConnectionStrategyADFS:
+		return connectionMetadataURL.Ask(cmd, &inputs.MetadataURL, nil)
+	}
+
+	return nil
+}
+
+// applyTo sets the strategy specific settings on the raw connection options,
+// using the option names expected by the Management API for that strategy.
+func (i *connectionStrategyInputs) applyTo(strategy string, options map[string]interface{}) error {
+	set := func(key, value string) {
+		if value != "" {
+			options[key] = value
+		}
+	}
+
+	switch connectionCategoryFor(strategy) {
+	case connectionCategoryDatabase:
+		if i.PasswordPolicy != "" && !slices.Contains(connectionPasswordPolicyOptions, i.PasswordPolicy) {
+			return fmt.Errorf("invalid password policy %q, must be one of: none, low, fair, good, excellent", i.PasswordPolicy)
+		}
+		set("passwordPolicy", i.PasswordPolicy)
+	case connectionCategorySocial, connectionCategoryEnterprise:
+		set("client_id", i.ClientID)
+		set("client_secret", i.ClientSecret)
+	}
+
+	switch strategy {
+	case management.ConnectionStrategyOIDC:
+		set("discovery_url", i.DiscoveryURL)
+	case management.ConnectionStrategyOkta, management.ConnectionStrategyAzureAD:
+		set("domain", i.Domain)
+	case management.ConnectionStrategySAML:
+		set("signInEndpoint", i.SignInURL)
+		if i.SigningCert != "" {
+			cert, err := os.ReadFile(i.SigningCert)
+			if err != nil {
+				return fmt.Errorf("failed to read signing certificate %q: %w", i.SigningCert, err)
+			}
+			set("signingCert", base64.StdEncoding.EncodeToString(cert))
+		}
+	case management.ConnectionStrategyADFS:
+		set("adfs_server", i.MetadataURL)
+	}
+
+	return nil
+}
+
+func connectionCategoryFor(strategy string) string {
+	switch {
+	case strategy == management.ConnectionStrategyAuth0:
+		return connectionCategoryDatabase
+	case strategy == management.ConnectionStrategyEmail, strategy == management.ConnectionStrategySMS:
+		return connectionCategoryPasswordless
+	case slices.Contains(connectionEnterpriseStrategies, strategy):
+		return connectionCategoryEnterprise
+	default:
+		return connectionCategorySocial
+	}
+}
+
+func formatConnectionSettingsPath(id, strategy string) string {
+	if len(id) == 0 {
+		return ""
+	}
+
+	switch category := connectionCategoryFor(strategy); category {
+	case connectionCategoryEnterprise:
+		return fmt.Sprintf("connections/enterprise/%s/%s/settings", strategy, id)
+	case connectionCategoryPasswordless:
+		return "connections/passwordless"
+	default:
+		return fmt.Sprintf("connections/%s/%s/settings", category, id)
+	}
+}
+
+func (c *cli) connectionPickerOptions(ctx context.Context) (pickerOptions, error) {
+	list, err := c.api.Connection.List(ctx, management.IncludeFields("id", "name", "strategy"))
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, connection := range list.Connections {
+		value := connection.GetID()
+		label := fmt.Sprintf("%s [%s] %s", connection.GetName(), connection.GetStrategy(), ansi.Faint("("+value+")"))
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no connections to choose from. Create one by running: `auth0 connections create`")
+	}
+
+	return opts, nil
+}
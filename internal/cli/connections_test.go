@@ -0,0 +1,253 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestListConnectionsCmd(t *testing.T) {
+	tests := []struct {
+		name           string
+		connectionList *management.ConnectionList
+	}{
+		{
+			name:           "nil connection list (no results)",
+			connectionList: nil,
+		},
+		{
+			name:           "empty connection list",
+			connectionList: &management.ConnectionList{Connections: []*management.Connection{}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			connectionAPI := mock.NewMockConnectionAPI(ctrl)
+			connectionAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(test.connectionList, nil)
+
+			cli := &cli{
+				renderer: &display.Renderer{
+					MessageWriter: io.Discard,
+					ResultWriter:  io.Discard,
+				},
+				api: &auth0.API{Connection: connectionAPI},
+			}
+
+			cmd := listConnectionsCmd(cli)
+			cmd.SetArgs([]string{})
+
+			assert.NoError(t, cmd.Execute())
+		})
+	}
+}
+
+func TestConnectionPickerOptions(t *testing.T) {
+	tests := []struct {
+		name         string
+		connections  []*management.Connection
+		apiError     error
+		assertOutput func(t testing.TB, options pickerOptions)
+		assertError  func(t testing.TB, err error)
+	}{
+		{
+			name: "happy path",
+			connections: []*management.Connection{
+				{
+					ID:       auth0.String("con_1"),
+					Name:     auth0.String("Username-Password-Authentication"),
+					Strategy: auth0.String("auth0"),
+				},
+				{
+					ID:       auth0.String("con_2"),
+					Name:     auth0.String("google-oauth2"),
+					Strategy: auth0.String("google-oauth2"),
+				},
+			},
+			assertOutput: func(t testing.TB, options pickerOptions) {
+				assert.Len(t, options, 2)
+				assert.Equal(t, "Username-Password-Authentication [auth0] (con_1)", options[0].label)
+				assert.Equal(t, "con_1", options[0].value)
+				assert.Equal(t, "google-oauth2 [google-oauth2] (con_2)", options[1].label)
+				assert.Equal(t, "con_2", options[1].value)
+			},
+			assertError: func(t testing.TB, err error) {
+				t.Fail()
+			},
+		},
+		{
+			name:        "no connections",
+			connections: []*management.Connection{},
+			assertOutput: func(t testing.TB, options pickerOptions) {
+				t.Fail()
+			},
+			assertError: func(t testing.TB, err error) {
+				assert.ErrorContains(t, err, "there are currently no connections to choose from. Create one by running: `auth0 connections create`")
+			},
+		},
+		{
+			name:     "API error",
+			apiError: errors.New("error"),
+			assertOutput: func(t testing.TB, options pickerOptions) {
+				t.Fail()
+			},
+			assertError: func(t testing.TB, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			connectionAPI := mock.NewMockConnectionAPI(ctrl)
+			connectionAPI.EXPECT().
+				List(gomock.Any(), gomock.Any()).
+				Return(&management.ConnectionList{
+					Connections: test.connections}, test.apiError)
+
+			cli := &cli{
+				api: &auth0.API{Connection: connectionAPI},
+			}
+
+			options, err := cli.connectionPickerOptions(context.Background())
+
+			if err != nil {
+				test.assertError(t, err)
+			} else {
+				test.assertOutput(t, options)
+			}
+		})
+	}
+}
+
+func TestGetConnectionEnabledClients(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	connectionAPI := mock.NewMockConnectionAPI(ctrl)
+	gomock.InOrder(
+		connectionAPI.EXPECT().
+			ReadEnabledClients(gomock.Any(), "con_1", gomock.Any()).
+			Return(&management.ConnectionEnabledClientList{
+				List:    management.List{Next: "checkpoint"},
+				Clients: &[]management.ConnectionEnabledClient{{ClientID: auth0.String("client-1")}},
+			}, nil),
+		connectionAPI.EXPECT().
+			ReadEnabledClients(gomock.Any(), "con_1", gomock.Any(), gomock.Any()).
+			Return(&management.ConnectionEnabledClientList{
+				Clients: &[]management.ConnectionEnabledClient{{ClientID: auth0.String("client-2")}},
+			}, nil),
+	)
+
+	clients, err := getConnectionEnabledClients(context.Background(), &auth0.API{Connection: connectionAPI}, "con_1")
+
+	require.NoError(t, err)
+	assert.Len(t, clients, 2)
+	assert.Equal(t, "client-1", clients[0].GetClientID())
+	assert.Equal(t, "client-2", clients[1].GetClientID())
+}
+
+func TestConnectionStrategyInputsApplyTo(t *testing.T) {
+	certFile := filepath.Join(t.TempDir(), "idp.pem")
+	require.NoError(t, os.WriteFile(certFile, []byte("cert"), 0600))
+
+	tests := []struct {
+		name     string
+		strategy string
+		inputs   connectionStrategyInputs
+		expected map[string]interface{}
+		err      string
+	}{
+		{
+			name:     "database",
+			strategy: "auth0",
+			inputs:   connectionStrategyInputs{PasswordPolicy: "good", ClientID: "ignored"},
+			expected: map[string]interface{}{"passwordPolicy": "good"},
+		},
+		{
+			name:     "database with invalid password policy",
+			strategy: "auth0",
+			inputs:   connectionStrategyInputs{PasswordPolicy: "strong"},
+			err:      `invalid password policy "strong"`,
+		},
+		{
+			name:     "passwordless",
+			strategy: "email",
+			inputs:   connectionStrategyInputs{ClientID: "ignored"},
+			expected: map[string]interface{}{},
+		},
+		{
+			name:     "social",
+			strategy: "github",
+			inputs:   connectionStrategyInputs{ClientID: "id", ClientSecret: "secret"},
+			expected: map[string]interface{}{"client_id": "id", "client_secret": "secret"},
+		},
+		{
+			name:     "oidc",
+			strategy: "oidc",
+			inputs:   connectionStrategyInputs{ClientID: "id", DiscoveryURL: "https://idp.example.com"},
+			expected: map[string]interface{}{"client_id": "id", "discovery_url": "https://idp.example.com"},
+		},
+		{
+			name:     "okta",
+			strategy: "okta",
+			inputs:   connectionStrategyInputs{Domain: "example.okta.com"},
+			expected: map[string]interface{}{"domain": "example.okta.com"},
+		},
+		{
+			name:     "samlp",
+			strategy: "samlp",
+			inputs:   connectionStrategyInputs{SignInURL: "https://idp.example.com/saml", SigningCert: certFile},
+			expected: map[string]interface{}{"signInEndpoint": "https://idp.example.com/saml", "signingCert": "Y2VydA=="},
+		},
+		{
+			name:     "adfs",
+			strategy: "adfs",
+			inputs:   connectionStrategyInputs{MetadataURL: "https://adfs.example.com/metadata.xml"},
+			expected: map[string]interface{}{"adfs_server": "https://adfs.example.com/metadata.xml"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := map[string]interface{}{}
+			err := test.inputs.applyTo(test.strategy, options)
+
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, options)
+		})
+	}
+}
+
+func TestFormatConnectionSettingsPath(t *testing.T) {
+	assert.Equal(t, "", formatConnectionSettingsPath("", "auth0"))
+	assert.Equal(t, "connections/database/con_1/settings", formatConnectionSettingsPath("con_1", "auth0"))
+	assert.Equal(t, "connections/passwordless", formatConnectionSettingsPath("con_1", "sms"))
+	assert.Equal(t, "connections/social/con_1/settings", formatConnectionSettingsPath("con_1", "github"))
+	assert.Equal(t, "connections/enterprise/samlp/con_1/settings", formatConnectionSettingsPath("con_1", "samlp"))
+}
@@ -0,0 +1,83 @@
+/**
+ * Runs an action handler locally against a sample event. Every call made on
+ * the api object is recorded instead of executed, and written together with
+ * the console output to the result file once the handler completes.
+ *
+ * Usage: node action-test-runner.js <action-file> <event-file> <handler> <result-file>
+ */
+'use strict';
+
+const fs = require('fs');
+const util = require('util');
+
+const [actionFile, eventFile, handlerName, resultFile] = process.argv.slice(2);
+
+const commands = [];
+const logs = [];
+
+function serialize(value) {
+  if (typeof value === 'function') {
+    return '[Function]';
+  }
+
+  try {
+    return JSON.parse(JSON.stringify(value));
+  } catch (err) {
+    return String(value);
+  }
+}
+
+// Any property of the api object can be accessed or called. Calls are recorded
+// with their full path, e.g. api.accessToken.setCustomClaim, and return the api
+// object itself so that chained calls keep working.
+function recorder(path) {
+  return new Proxy(function () {}, {
+    get(target, prop) {
+      // Prevent the api object from being treated as a promise when awaited.
+      if (prop === 'then' || typeof prop === 'symbol') {
+        return undefined;
+      }
+
+      return recorder(path.concat(prop));
+    },
+    apply(target, thisArg, args) {
+      commands.push({ name: path.join('.'), args: args.map(serialize) });
+      return api;
+    },
+  });
+}
+
+const api = recorder(['api']);
+
+for (const level of ['log', 'info', 'warn', 'error', 'debug']) {
+  console[level] = (...args) => {
+    const message = util.format(...args);
+    logs.push(level === 'log' ? message : `[${level}] ${message}`);
+  };
+}
+
+function finish(err) {
+  const result = { commands, logs };
+  if (err) {
+    result.error = err instanceof Error ? err.message : String(err);
+  }
+
+  fs.writeFileSync(resultFile, JSON.stringify(result));
+
+  // Pending timers or sockets opened by the action must not keep the process alive.
+  process.exit(0);
+}
+
+async function main() {
+  const event = JSON.parse(fs.readFileSync(eventFile, 'utf8'));
+  const action = require(actionFile);
+
+  const handler = action[handlerName];
+  if (typeof handler !== 'function') {
+    throw new Error(`the action does not export an ${handlerName} handler`);
+  }
+
+  await handler(event, api);
+}
+
+main().then(() => finish(), finish);
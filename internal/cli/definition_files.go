@@ -0,0 +1,364 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+// definition is the content of a definition file, such as *formDefinition.
+type definition interface {
+	header() *definitionHeader
+}
+
+// definitionHeader identifies the resource of a definition file.
+type definitionHeader struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// definitionResource describes how the pull and push commands of a resource,
+// such as forms and flows, read and write the resources of the tenant.
+type definitionResource[D definition] struct {
+	singular string
+	plural   string
+
+	// list returns the resources of the tenant, which don't need to hold their content.
+	list func(ctx context.Context) ([]definitionHeader, error)
+	// read returns the definition of a resource of the tenant.
+	read func(ctx context.Context, id string) (D, error)
+	// empty returns a definition to read a file into.
+	empty func() D
+	// check is optional, and rejects a definition before any definition is pushed.
+	check  func(ctx context.Context, path string, definition D) error
+	create func(ctx context.Context, definition D) (string, error)
+	update func(ctx context.Context, id string, definition D) error
+}
+
+func (h *definitionHeader) header() *definitionHeader {
+	return h
+}
+
+// definitionFiles indexes the JSON definition files of a directory, such as
+// the ones of forms and flows, by the ID of the resource they define.
+type definitionFiles struct {
+	dir   string
+	paths map[string]string
+	used  map[string]bool
+}
+
+// readDefinitionFiles indexes the JSON definition files found in the
+// directory, which doesn't have to exist yet.
+func readDefinitionFiles(dir string) (*definitionFiles, error) {
+	files := &definitionFiles{
+		dir:   dir,
+		paths: map[string]string{},
+		used:  map[string]bool{},
+	}
+
+	paths, err := listDefinitionFiles(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return files, nil
+		}
+		return nil, err
+	}
+
+	for _, path := range paths {
+		files.used[strings.TrimSuffix(filepath.Base(path), ".json")] = true
+
+		var definition struct {
+			ID string `json:"id"`
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(content, &definition); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", path, err)
+		}
+		if definition.ID != "" {
+			files.paths[definition.ID] = path
+		}
+	}
+
+	return files, nil
+}
+
+// path returns the file of the resource, keeping the file it was previously
+// pulled to so that renaming a resource doesn't duplicate its definition.
+func (f *definitionFiles) path(id, name string) string {
+	if path, ok := f.paths[id]; ok {
+		return path
+	}
+
+	path := filepath.Join(f.dir, tenantResourceFileName(name, f.used)+".json")
+	f.paths[id] = path
+
+	return path
+}
+
+// listDefinitionFiles returns the JSON files of the directory, sorted by name.
+func listDefinitionFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".json" {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+func writeDefinitionFile(path string, definition interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(definition, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(content, '\n'), 0600)
+}
+
+// readDefinitionFile decodes a JSON definition file, rejecting unknown fields
+// so that typos don't silently get dropped.
+func readDefinitionFile(path string, definition interface{}) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err := decodeDefinition(content, definition); err != nil {
+		return fmt.Errorf("failed to parse %q: %w", path, err)
+	}
+
+	return nil
+}
+
+func decodeDefinition(content []byte, definition interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(definition)
+}
+
+// definitionNames indexes the IDs of the resources of the tenant by name, to
+// match the definitions pulled from another tenant, as IDs differ from one
+// tenant to another. The resources are only listed when first needed.
+type definitionNames struct {
+	resource string
+	list     func() (map[string][]string, error)
+	ids      map[string][]string
+}
+
+// id returns the ID of the resource with the given name,
+// or an empty string if the tenant has no such resource.
+func (n *definitionNames) id(name string) (string, error) {
+	if n.ids == nil {
+		ids, err := n.list()
+		if err != nil {
+			return "", err
+		}
+		n.ids = ids
+	}
+
+	switch ids := n.ids[name]; len(ids) {
+	case 0:
+		return "", nil
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d %s named %q, rename them so that they can be told apart", len(ids), n.resource, name)
+	}
+}
+
+func isNotFoundError(err error) bool {
+	var mErr management.Error
+	return errors.As(err, &mErr) && mErr.Status() == http.StatusNotFound
+}
+
+// pullDefinitions writes the definitions of the resources with the given IDs,
+// or of all the resources of the tenant, to the files of the directory.
+func pullDefinitions[D definition](ctx context.Context, cli *cli, r definitionResource[D], dir string, ids []string) error {
+	var definitions []D
+	if err := ansi.Waiting(func() error {
+		if len(ids) == 0 {
+			list, err := r.list(ctx)
+			if err != nil {
+				return err
+			}
+
+			// The resources of the list don't hold their content.
+			for _, resource := range list {
+				ids = append(ids, resource.ID)
+			}
+		}
+
+		for _, id := range ids {
+			definition, err := r.read(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to read %s with ID %q: %w", r.singular, id, err)
+			}
+			definitions = append(definitions, definition)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	files, err := readDefinitionFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	var pulled []display.DefinitionFile
+	for _, definition := range definitions {
+		header := definition.header()
+		path := files.path(header.ID, header.Name)
+
+		if err := writeDefinitionFile(path, definition); err != nil {
+			return fmt.Errorf("failed to write %s with ID %q: %w", r.singular, header.ID, err)
+		}
+
+		pulled = append(pulled, display.DefinitionFile{
+			ID:     header.ID,
+			Name:   header.Name,
+			Path:   path,
+			Action: "pulled",
+		})
+	}
+
+	cli.renderer.DefinitionFiles(r.plural, "pulled", pulled)
+
+	return nil
+}
+
+// pushDefinitions applies the definition files of the directory to the tenant.
+// The files are all read and checked first, so that an invalid file doesn't
+// leave the tenant half pushed.
+func pushDefinitions[D definition](ctx context.Context, cli *cli, r definitionResource[D], dir string) error {
+	paths, err := listDefinitionFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	definitions := make([]D, len(paths))
+	for i, path := range paths {
+		definitions[i] = r.empty()
+		if err := readDefinitionFile(path, definitions[i]); err != nil {
+			return err
+		}
+		if definitions[i].header().Name == "" {
+			return fmt.Errorf("missing required field \"name\" in %q", path)
+		}
+	}
+
+	if r.check != nil {
+		for i, path := range paths {
+			if err := r.check(ctx, path, definitions[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	indexes := make([]int, len(paths))
+	for i := range paths {
+		indexes[i] = i
+	}
+
+	names := &definitionNames{
+		resource: r.plural,
+		list: func() (map[string][]string, error) {
+			list, err := r.list(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			ids := make(map[string][]string, len(list))
+			for _, resource := range list {
+				ids[resource.Name] = append(ids[resource.Name], resource.ID)
+			}
+			return ids, nil
+		},
+	}
+
+	var pushed []display.DefinitionFile
+	err = ansi.ProgressBar(fmt.Sprintf("Pushing %s(s)", r.singular), indexes, func(_ int, i int) error {
+		path, definition := paths[i], definitions[i]
+		header := definition.header()
+
+		id, action := header.ID, "updated"
+		if id != "" {
+			err := r.update(ctx, id, definition)
+			switch {
+			case err == nil:
+			case isNotFoundError(err):
+				// The file was pulled from another tenant, so the resource is matched by name instead.
+				if id, err = names.id(header.Name); err != nil {
+					return fmt.Errorf("failed to match %s from %q by name: %w", r.singular, path, err)
+				}
+				if id != "" {
+					if err := r.update(ctx, id, definition); err != nil {
+						return fmt.Errorf("failed to update %s with ID %q from %q: %w", r.singular, id, path, err)
+					}
+				}
+			default:
+				return fmt.Errorf("failed to update %s with ID %q from %q: %w", r.singular, id, path, err)
+			}
+		}
+
+		if id == "" {
+			created, err := r.create(ctx, definition)
+			if err != nil {
+				return fmt.Errorf("failed to create %s from %q: %w", r.singular, path, err)
+			}
+			id, action = created, "created"
+
+			// Files pulled from another tenant keep the ID of the resource of that tenant.
+			if header.ID == "" {
+				header.ID = id
+				if err := writeDefinitionFile(path, definition); err != nil {
+					return fmt.Errorf("failed to write the ID of the created %s to %q: %w", r.singular, path, err)
+				}
+			}
+		}
+
+		pushed = append(pushed, display.DefinitionFile{
+			ID:     id,
+			Name:   header.Name,
+			Path:   path,
+			Action: action,
+		})
+
+		return nil
+	})
+
+	// The files pushed before a failure are listed too, so that only the failed ones need another look.
+	if err == nil || len(pushed) > 0 {
+		cli.renderer.DefinitionFiles(r.plural, "pushed", pushed)
+	}
+
+	return err
+}
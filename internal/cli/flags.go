@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -161,6 +162,10 @@ func (f *Flag) RegisterIntU(cmd *cobra.Command, value *int, defaultValue int) {
 	registerInt(cmd, f, value, defaultValue, true)
 }
 
+func (f *Flag) RegisterDuration(cmd *cobra.Command, value *time.Duration, defaultValue time.Duration) {
+	registerDuration(cmd, f, value, defaultValue, false)
+}
+
 func (f *Flag) RegisterBool(cmd *cobra.Command, value *bool, defaultValue bool) {
 	registerBool(cmd, f, value, defaultValue, false)
 }
@@ -387,6 +392,14 @@ func registerInt(cmd *cobra.Command, f *Flag, value *int, defaultValue int, isUp
 	}
 }
 
+func registerDuration(cmd *cobra.Command, f *Flag, value *time.Duration, defaultValue time.Duration, isUpdate bool) {
+	cmd.Flags().DurationVarP(value, f.LongForm, f.ShortForm, defaultValue, f.Help)
+
+	if err := markFlagRequired(cmd, f, isUpdate); err != nil {
+		panic(auth0.Error(err, "failed to register duration flag"))
+	}
+}
+
 func registerIntSlice(cmd *cobra.Command, f *Flag, value *[]int, defaultValue []int, isUpdate bool) {
 	cmd.Flags().IntSliceVarP(value, f.LongForm, f.ShortForm, defaultValue, f.Help)
 
@@ -0,0 +1,727 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+const defaultFlowContent = `{
+  "actions": []
+}`
+
+var (
+	flowID = Argument{
+		Name: "Flow ID",
+		Help: "Id of the flow.",
+	}
+
+	flowName = Flag{
+		Name:       "Name",
+		LongForm:   "name",
+		ShortForm:  "n",
+		Help:       "Name of the flow.",
+		IsRequired: true,
+	}
+
+	flowContentFlag = Flag{
+		Name:     "Definition",
+		LongForm: "definition",
+		Help:     "Definition of the flow, formatted as JSON. It holds the actions of the flow.",
+	}
+
+	flowNumber = Flag{
+		Name:      "Number",
+		LongForm:  "number",
+		ShortForm: "n",
+		Help:      "Number of flows to retrieve. Minimum 1, maximum 1000.",
+	}
+
+	flowDir = Flag{
+		Name:       "Directory",
+		LongForm:   "dir",
+		ShortForm:  "d",
+		Help:       "Directory holding the definition files of the flows.",
+		IsRequired: true,
+	}
+
+	flowVaultConnectionID = Argument{
+		Name: "Connection ID",
+		Help: "Id of the flow vault connection.",
+	}
+
+	flowVaultConnectionNumber = Flag{
+		Name:      "Number",
+		LongForm:  "number",
+		ShortForm: "n",
+		Help:      "Number of flow vault connections to retrieve. Minimum 1, maximum 1000.",
+	}
+)
+
+// flowContent is the editable definition of a flow.
+type flowContent struct {
+	Actions []interface{} `json:"actions"`
+}
+
+// flowDefinition is the content of the definition file of a flow.
+type flowDefinition struct {
+	definitionHeader
+	flowContent
+}
+
+func newFlowContent(flow *management.Flow) flowContent {
+	actions := flow.Actions
+	if actions == nil {
+		actions = []interface{}{}
+	}
+
+	return flowContent{Actions: actions}
+}
+
+func (d *flowDefinition) flow() *management.Flow {
+	return &management.Flow{
+		Name:    &d.Name,
+		Actions: d.Actions,
+	}
+}
+
+func flowsCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flows",
+		Short: "Manage resources for flows",
+		Long: "Flows run a sequence of actions, such as calling third-party services through vault connections, " +
+			"when a form is submitted. Use `pull` and `push` to keep their definitions in version control. To learn more, read " +
+			"[Flows](https://auth0.com/docs/customize/forms/flows).",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listFlowsCmd(cli))
+	cmd.AddCommand(showFlowCmd(cli))
+	cmd.AddCommand(createFlowCmd(cli))
+	cmd.AddCommand(updateFlowCmd(cli))
+	cmd.AddCommand(deleteFlowCmd(cli))
+	cmd.AddCommand(pullFlowsCmd(cli))
+	cmd.AddCommand(pushFlowsCmd(cli))
+	cmd.AddCommand(flowVaultConnectionsCmd(cli))
+
+	return cmd
+}
+
+func listFlowsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Number int
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List your flows",
+		Long:    "List your existing flows. To create one, run: `auth0 flows create`.",
+		Example: `  auth0 flows list
+  auth0 flows ls
+  auth0 flows ls --number 100
+  auth0 flows ls -n 100 --json
+  auth0 flows ls -n 100 --json-compact
+  auth0 flows ls --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Number < 1 || inputs.Number > 1000 {
+				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
+			}
+
+			list, err := getWithPagination(
+				inputs.Number,
+				func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+					flows, err := cli.api.Flow.List(cmd.Context(), opts...)
+					if err != nil {
+						return nil, false, err
+					}
+
+					for _, flow := range flows.Flows {
+						result = append(result, flow)
+					}
+
+					return result, flows.HasNext(), nil
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to list flows: %w", err)
+			}
+
+			var flows []*management.Flow
+			for _, item := range list {
+				flows = append(flows, item.(*management.Flow))
+			}
+
+			cli.renderer.FlowList(flows)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	flowNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+
+	return cmd
+}
+
+func showFlowCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show a flow",
+		Long:  "Display information about a flow.",
+		Example: `  auth0 flows show
+  auth0 flows show <flow-id>
+  auth0 flows show <flow-id> --json
+  auth0 flows show <flow-id> --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := flowID.Pick(cmd, &inputs.ID, cli.flowPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var flow *management.Flow
+			if err := ansi.Waiting(func() (err error) {
+				flow, err = cli.api.Flow.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read flow with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.FlowShow(flow)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func createFlowCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Name       string
+		Definition string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Args:  cobra.NoArgs,
+		Short: "Create a new flow",
+		Long: "Create a new flow.\n\n" +
+			"To create interactively, use `auth0 flows create` with no flags. " +
+			"The definition of the flow will be opened as JSON in your default editor.\n\n" +
+			"To create non-interactively, supply the name and the definition through the flags.",
+		Example: `  auth0 flows create
+  auth0 flows create --name "Sync Profile"
+  auth0 flows create -n "Sync Profile" --definition "$(cat flow.json)"
+  auth0 flows create -n "Sync Profile" --definition "$(cat flow.json)" --json
+  auth0 flows create -n "Sync Profile" --definition "$(cat flow.json)" --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := flowName.Ask(cmd, &inputs.Name, nil); err != nil {
+				return err
+			}
+
+			if err := flowContentFlag.OpenEditor(
+				cmd,
+				&inputs.Definition,
+				defaultFlowContent,
+				"flow.*.json",
+				cli.flowEditorHint,
+			); err != nil {
+				return fmt.Errorf("failed to capture input from the editor: %w", err)
+			}
+
+			flow := &management.Flow{
+				Name: &inputs.Name,
+			}
+
+			if inputs.Definition != "" {
+				var content flowContent
+				if err := decodeDefinition([]byte(inputs.Definition), &content); err != nil {
+					return fmt.Errorf("invalid JSON input for the definition: %w", err)
+				}
+				flow.Actions = content.Actions
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Flow.Create(cmd.Context(), flow)
+			}); err != nil {
+				return fmt.Errorf("failed to create flow: %w", err)
+			}
+
+			cli.renderer.FlowCreate(flow)
+			return nil
+		},
+	}
+
+	flowName.RegisterString(cmd, &inputs.Name, "")
+	flowContentFlag.RegisterString(cmd, &inputs.Definition, "")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func updateFlowCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID         string
+		Name       string
+		Definition string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Update a flow",
+		Long: "Update a flow.\n\n" +
+			"To update interactively, use `auth0 flows update` with no arguments. " +
+			"The current definition of the flow will be opened as JSON in your default editor.\n\n" +
+			"To update non-interactively, supply the flow id and the settings to change through the flags.",
+		Example: `  auth0 flows update
+  auth0 flows update <flow-id>
+  auth0 flows update <flow-id> --name "Sync Profile"
+  auth0 flows update <flow-id> --definition "$(cat flow.json)"
+  auth0 flows update <flow-id> -n "Sync Profile" --definition "$(cat flow.json)" --json
+  auth0 flows update <flow-id> -n "Sync Profile" --definition "$(cat flow.json)" --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := flowID.Pick(cmd, &inputs.ID, cli.flowPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var current *management.Flow
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.api.Flow.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read flow with ID %q: %w", inputs.ID, err)
+			}
+
+			if noLocalFlagSet(cmd) {
+				if err := flowName.AskU(cmd, &inputs.Name, current.Name); err != nil {
+					return err
+				}
+
+				currentContent, err := json.MarshalIndent(newFlowContent(current), "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to serialize the definition of flow with ID %q: %w", inputs.ID, err)
+				}
+
+				if err := flowContentFlag.OpenEditorU(
+					cmd,
+					&inputs.Definition,
+					string(currentContent),
+					current.GetName()+".*.json",
+				); err != nil {
+					return fmt.Errorf("failed to capture input from the editor: %w", err)
+				}
+			}
+
+			update := &management.Flow{}
+			if inputs.Name != "" {
+				update.Name = &inputs.Name
+			}
+			if inputs.Definition != "" {
+				var content flowContent
+				if err := decodeDefinition([]byte(inputs.Definition), &content); err != nil {
+					return fmt.Errorf("invalid JSON input for the definition: %w", err)
+				}
+				update.Actions = content.Actions
+			}
+
+			var updated *management.Flow
+			if err := ansi.Waiting(func() (err error) {
+				if err = cli.api.Flow.Update(cmd.Context(), inputs.ID, update); err != nil {
+					return err
+				}
+				updated, err = cli.api.Flow.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to update flow with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.FlowUpdate(updated)
+			return nil
+		},
+	}
+
+	flowName.RegisterStringU(cmd, &inputs.Name, "")
+	flowContentFlag.RegisterStringU(cmd, &inputs.Definition, "")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func deleteFlowCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a flow",
+		Long: "Delete a flow.\n\n" +
+			"To delete interactively, use `auth0 flows delete` with no arguments.\n\n" +
+			"To delete non-interactively, supply the flow id and the `--force` flag to skip confirmation.",
+		Example: `  auth0 flows delete
+  auth0 flows rm
+  auth0 flows delete <flow-id>
+  auth0 flows delete <flow-id> --force
+  auth0 flows delete <flow-id> <flow-id2> <flow-idn>
+  auth0 flows delete <flow-id> <flow-id2> <flow-idn> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var ids []string
+			if len(args) == 0 {
+				if err := flowID.PickMany(cmd, &ids, cli.flowPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				ids = args
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			return ansi.ProgressBar("Deleting flow(s)", ids, func(_ int, id string) error {
+				if id != "" {
+					if err := cli.api.Flow.Delete(cmd.Context(), id); err != nil {
+						return fmt.Errorf("failed to delete flow with ID %q: %w", id, err)
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func pullFlowsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Dir string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "pull",
+		Args:  cobra.ArbitraryArgs,
+		Short: "Pull the definitions of flows to local files",
+		Long: "Pull the definitions of flows to JSON files in a directory, one file per flow. " +
+			"All flows are pulled unless flow ids are given.\n\n" +
+			"Files are named after the flows. A flow that was pulled before is written to the same file, even if it was renamed. " +
+			"Use `auth0 flows push` to apply the files to the tenant.",
+		Example: `  auth0 flows pull --dir ./flows
+  auth0 flows pull <flow-id> --dir ./flows
+  auth0 flows pull <flow-id> <flow-id2> -d ./flows --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return pullDefinitions(cmd.Context(), cli, cli.flowDefinitions(), inputs.Dir, args)
+		},
+	}
+
+	flowDir.RegisterString(cmd, &inputs.Dir, "")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func pushFlowsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Dir string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "push",
+		Args:  cobra.NoArgs,
+		Short: "Push the definitions of flows from local files",
+		Long: "Push the definitions of flows from the JSON files of a directory to the tenant.\n\n" +
+			"Flows are matched by the id of their file, or by name when the tenant has no flow with that id, " +
+			"such as when pushing files pulled from another tenant. Files without an id create a new flow, " +
+			"and the id of the created flow is written back to the file. Flows without a file are left untouched.",
+		Example: `  auth0 flows push --dir ./flows
+  auth0 flows push -d ./flows --json
+  auth0 flows push -d ./flows --tenant prod.auth0.com`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return pushDefinitions(cmd.Context(), cli, cli.flowDefinitions(), inputs.Dir)
+		},
+	}
+
+	flowDir.RegisterString(cmd, &inputs.Dir, "")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func flowVaultConnectionsCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vault-connections",
+		Aliases: []string{"vault"},
+		Short:   "Manage the vault connections of flows",
+		Long: "Vault connections hold the credentials flows use to call third-party services. " +
+			"They are set up from the Auth0 Dashboard.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listFlowVaultConnectionsCmd(cli))
+	cmd.AddCommand(deleteFlowVaultConnectionCmd(cli))
+
+	return cmd
+}
+
+func listFlowVaultConnectionsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Number int
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List the vault connections of flows",
+		Long:    "List the vault connections of flows. The credentials of the connections are never displayed.",
+		Example: `  auth0 flows vault-connections list
+  auth0 flows vault-connections ls
+  auth0 flows vault-connections ls --number 100
+  auth0 flows vault ls -n 100 --json
+  auth0 flows vault ls -n 100 --json-compact
+  auth0 flows vault ls --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Number < 1 || inputs.Number > 1000 {
+				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
+			}
+
+			list, err := getWithPagination(
+				inputs.Number,
+				func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+					connections, err := cli.api.FlowVaultConnection.GetConnectionList(cmd.Context(), opts...)
+					if err != nil {
+						return nil, false, err
+					}
+
+					for _, connection := range connections.Connections {
+						result = append(result, connection)
+					}
+
+					return result, connections.HasNext(), nil
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to list flow vault connections: %w", err)
+			}
+
+			var connections []*management.FlowVaultConnection
+			for _, item := range list {
+				connections = append(connections, item.(*management.FlowVaultConnection))
+			}
+
+			cli.renderer.FlowVaultConnectionList(connections)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	flowVaultConnectionNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+
+	return cmd
+}
+
+func deleteFlowVaultConnectionCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a vault connection of flows",
+		Long: "Delete a vault connection of flows. Flows using the connection will fail to call the connected service.\n\n" +
+			"To delete interactively, use `auth0 flows vault-connections delete` with no arguments.\n\n" +
+			"To delete non-interactively, supply the connection id and the `--force` flag to skip confirmation.",
+		Example: `  auth0 flows vault-connections delete
+  auth0 flows vault rm
+  auth0 flows vault-connections delete <connection-id>
+  auth0 flows vault-connections delete <connection-id> --force
+  auth0 flows vault-connections delete <connection-id> <connection-id2> <connection-idn>
+  auth0 flows vault-connections delete <connection-id> <connection-id2> <connection-idn> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var ids []string
+			if len(args) == 0 {
+				if err := flowVaultConnectionID.PickMany(cmd, &ids, cli.flowVaultConnectionPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				ids = args
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			return ansi.ProgressBar("Deleting flow vault connection(s)", ids, func(_ int, id string) error {
+				if id != "" {
+					if err := cli.api.FlowVaultConnection.DeleteConnection(cmd.Context(), id); err != nil {
+						return fmt.Errorf("failed to delete flow vault connection with ID %q: %w", id, err)
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+// getAllFlows lists every flow, without the spinner of getWithPagination.
+func (c *cli) getAllFlows(ctx context.Context) ([]*management.Flow, error) {
+	var flows []*management.Flow
+	for page := 0; ; page++ {
+		list, err := c.api.Flow.List(ctx, management.PerPage(defaultPageSize), management.Page(page))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list flows: %w", err)
+		}
+
+		flows = append(flows, list.Flows...)
+
+		if !list.HasNext() {
+			return flows, nil
+		}
+	}
+}
+
+// flowDefinitions is the resource of the pull and push commands of flows.
+func (c *cli) flowDefinitions() definitionResource[*flowDefinition] {
+	return definitionResource[*flowDefinition]{
+		singular: "flow",
+		plural:   "flows",
+		list: func(ctx context.Context) ([]definitionHeader, error) {
+			flows, err := c.getAllFlows(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			headers := make([]definitionHeader, 0, len(flows))
+			for _, flow := range flows {
+				headers = append(headers, definitionHeader{ID: flow.GetID(), Name: flow.GetName()})
+			}
+			return headers, nil
+		},
+		read: func(ctx context.Context, id string) (*flowDefinition, error) {
+			flow, err := c.api.Flow.Read(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			return &flowDefinition{
+				definitionHeader: definitionHeader{ID: flow.GetID(), Name: flow.GetName()},
+				flowContent:      newFlowContent(flow),
+			}, nil
+		},
+		empty: func() *flowDefinition {
+			return &flowDefinition{}
+		},
+		create: func(ctx context.Context, definition *flowDefinition) (string, error) {
+			flow := definition.flow()
+			if err := c.api.Flow.Create(ctx, flow); err != nil {
+				return "", err
+			}
+
+			return flow.GetID(), nil
+		},
+		update: func(ctx context.Context, id string, definition *flowDefinition) error {
+			return c.api.Flow.Update(ctx, id, definition.flow())
+		},
+	}
+}
+
+func (c *cli) flowPickerOptions(ctx context.Context) (pickerOptions, error) {
+	flows, err := c.getAllFlows(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, flow := range flows {
+		value := flow.GetID()
+		label := fmt.Sprintf("%s %s", flow.GetName(), ansi.Faint("("+value+")"))
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no flows to choose from. Create one by running: `auth0 flows create`")
+	}
+
+	return opts, nil
+}
+
+func (c *cli) flowVaultConnectionPickerOptions(ctx context.Context) (pickerOptions, error) {
+	list, err := c.api.FlowVaultConnection.GetConnectionList(ctx, management.PerPage(defaultPageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, connection := range list.Connections {
+		value := connection.GetID()
+		label := fmt.Sprintf("%s %s", connection.GetName(), ansi.Faint("("+value+")"))
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no flow vault connections to choose from")
+	}
+
+	return opts, nil
+}
+
+func (c *cli) flowEditorHint() {
+	c.renderer.Infof("%s Once you close the editor, the flow will be created. To cancel, press CTRL+C.", ansi.Faint("Hint:"))
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestFlowsUpdateCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	flowAPI := mock.NewMockFlowAPI(ctrl)
+	flowAPI.EXPECT().
+		Read(gomock.Any(), "af_1").
+		Return(&management.Flow{ID: auth0.String("af_1"), Name: auth0.String("Sync Profile")}, nil).
+		Times(2)
+	flowAPI.EXPECT().
+		Update(gomock.Any(), "af_1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, flow *management.Flow, _ ...management.RequestOption) error {
+			assert.Nil(t, flow.Name)
+			require.Len(t, flow.Actions, 1)
+			return nil
+		})
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Flow: flowAPI},
+	}
+
+	cmd := updateFlowCmd(cli)
+	cmd.SetArgs([]string{"af_1", "--definition", `{"actions": [{"id": "update_user", "type": "AUTH0", "action": "UPDATE_USER"}]}`})
+	require.NoError(t, cmd.Execute())
+}
+
+func TestFlowVaultConnectionsListCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultAPI := mock.NewMockFlowVaultConnectionAPI(ctrl)
+	vaultAPI.EXPECT().
+		GetConnectionList(gomock.Any(), gomock.Any()).
+		Return(&management.FlowVaultConnectionList{Connections: []*management.FlowVaultConnection{
+			{
+				ID:    auth0.String("ac_1"),
+				Name:  auth0.String("Slack"),
+				AppID: auth0.String("SLACK"),
+				Ready: auth0.Bool(true),
+				Setup: &map[string]interface{}{"token": "secret"},
+			},
+		}}, nil)
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+		api:      &auth0.API{FlowVaultConnection: vaultAPI},
+	}
+
+	cmd := listFlowVaultConnectionsCmd(cli)
+	cmd.SetArgs([]string{})
+	require.NoError(t, cmd.Execute())
+
+	assert.JSONEq(t, `[{"id": "ac_1", "name": "Slack", "app_id": "SLACK", "ready": true}]`, buf.String())
+}
+
+func TestFlowsPushCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sync-profile.json"), []byte(`{"id": "af_1", "name": "Sync Profile", "actions": []}`), 0600))
+
+	flowAPI := mock.NewMockFlowAPI(ctrl)
+	flowAPI.EXPECT().
+		Update(gomock.Any(), "af_1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, flow *management.Flow, _ ...management.RequestOption) error {
+			assert.Equal(t, "Sync Profile", flow.GetName())
+			return nil
+		})
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+		api:      &auth0.API{Flow: flowAPI},
+	}
+
+	cmd := pushFlowsCmd(cli)
+	cmd.SetArgs([]string{"--dir", dir})
+	require.NoError(t, cmd.Execute())
+
+	assert.JSONEq(t, `[{"id": "af_1", "name": "Sync Profile", "path": "`+filepath.Join(dir, "sync-profile.json")+`", "action": "updated"}]`, buf.String())
+}
+
+func TestFlowsPushCmdListsFlowsPushedBeforeAFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(`{"id": "af_1", "name": "Sync Profile", "actions": []}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.json"), []byte(`{"id": "af_2", "name": "Notify", "actions": []}`), 0600))
+
+	flowAPI := mock.NewMockFlowAPI(ctrl)
+	flowAPI.EXPECT().Update(gomock.Any(), "af_1", gomock.Any()).Return(nil)
+	flowAPI.EXPECT().Update(gomock.Any(), "af_2", gomock.Any()).Return(testManagementError{message: "Bad Request", status: 400})
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+		api:      &auth0.API{Flow: flowAPI},
+	}
+
+	cmd := pushFlowsCmd(cli)
+	cmd.SetArgs([]string{"--dir", dir})
+	assert.ErrorContains(t, cmd.Execute(), `failed to update flow with ID "af_2"`)
+
+	assert.JSONEq(t, `[{"id": "af_1", "name": "Sync Profile", "path": "`+filepath.Join(dir, "a.json")+`", "action": "updated"}]`, buf.String())
+}
@@ -0,0 +1,660 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+const defaultFormContent = `{
+  "languages": {
+    "primary": "en"
+  },
+  "start": {
+    "coordinates": {
+      "x": 0,
+      "y": 0
+    }
+  },
+  "nodes": [],
+  "ending": {
+    "coordinates": {
+      "x": 1250,
+      "y": 0
+    },
+    "resume_flow": true
+  }
+}`
+
+var (
+	formID = Argument{
+		Name: "Form ID",
+		Help: "Id of the form.",
+	}
+
+	formName = Flag{
+		Name:       "Name",
+		LongForm:   "name",
+		ShortForm:  "n",
+		Help:       "Name of the form.",
+		IsRequired: true,
+	}
+
+	formContentFlag = Flag{
+		Name:     "Definition",
+		LongForm: "definition",
+		Help: "Definition of the form, formatted as JSON. It holds the languages, messages, translations, " +
+			"start, nodes, ending and style of the form.",
+	}
+
+	formNumber = Flag{
+		Name:      "Number",
+		LongForm:  "number",
+		ShortForm: "n",
+		Help:      "Number of forms to retrieve. Minimum 1, maximum 1000.",
+	}
+
+	formDir = Flag{
+		Name:       "Directory",
+		LongForm:   "dir",
+		ShortForm:  "d",
+		Help:       "Directory holding the definition files of the forms.",
+		IsRequired: true,
+	}
+)
+
+// formContent is the editable definition of a form.
+type formContent struct {
+	Languages    *management.FormLanguages `json:"languages,omitempty"`
+	Messages     *management.FormMessages  `json:"messages,omitempty"`
+	Translations *map[string]interface{}   `json:"translations,omitempty"`
+	Start        *map[string]interface{}   `json:"start,omitempty"`
+	Nodes        []interface{}             `json:"nodes,omitempty"`
+	Ending       *map[string]interface{}   `json:"ending,omitempty"`
+	Style        *map[string]interface{}   `json:"style,omitempty"`
+}
+
+// formDefinition is the content of the definition file of a form.
+type formDefinition struct {
+	definitionHeader
+	formContent
+}
+
+func (d *formDefinition) form() *management.Form {
+	form := &management.Form{Name: &d.Name}
+	d.formContent.applyTo(form)
+
+	return form
+}
+
+func newFormContent(form *management.Form) formContent {
+	return formContent{
+		Languages:    form.Languages,
+		Messages:     form.Messages,
+		Translations: form.Translations,
+		Start:        form.Start,
+		Nodes:        form.Nodes,
+		Ending:       form.Ending,
+		Style:        form.Style,
+	}
+}
+
+// flowIDs returns the IDs of the flows run by the nodes of the form.
+func (c formContent) flowIDs() []string {
+	var ids []string
+	for _, node := range c.Nodes {
+		node, ok := node.(map[string]interface{})
+		if !ok || node["type"] != "FLOW" {
+			continue
+		}
+
+		config, _ := node["config"].(map[string]interface{})
+		if id, _ := config["flow_id"].(string); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func (c formContent) applyTo(form *management.Form) {
+	form.Languages = c.Languages
+	form.Messages = c.Messages
+	form.Translations = c.Translations
+	form.Start = c.Start
+	form.Nodes = c.Nodes
+	form.Ending = c.Ending
+	form.Style = c.Style
+}
+
+func formsCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forms",
+		Short: "Manage resources for forms",
+		Long: "Forms let you add custom steps to your login flows, such as collecting additional information " +
+			"from your users. Use `pull` and `push` to keep their definitions in version control. To learn more, read " +
+			"[Forms for Actions](https://auth0.com/docs/customize/forms).",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listFormsCmd(cli))
+	cmd.AddCommand(showFormCmd(cli))
+	cmd.AddCommand(createFormCmd(cli))
+	cmd.AddCommand(updateFormCmd(cli))
+	cmd.AddCommand(deleteFormCmd(cli))
+	cmd.AddCommand(pullFormsCmd(cli))
+	cmd.AddCommand(pushFormsCmd(cli))
+
+	return cmd
+}
+
+func listFormsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Number int
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List your forms",
+		Long:    "List your existing forms. To create one, run: `auth0 forms create`.",
+		Example: `  auth0 forms list
+  auth0 forms ls
+  auth0 forms ls --number 100
+  auth0 forms ls -n 100 --json
+  auth0 forms ls -n 100 --json-compact
+  auth0 forms ls --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Number < 1 || inputs.Number > 1000 {
+				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
+			}
+
+			forms, err := cli.getForms(cmd.Context(), inputs.Number)
+			if err != nil {
+				return err
+			}
+
+			cli.renderer.FormList(forms)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	formNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+
+	return cmd
+}
+
+func showFormCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show a form",
+		Long:  "Display information about a form.",
+		Example: `  auth0 forms show
+  auth0 forms show <form-id>
+  auth0 forms show <form-id> --json
+  auth0 forms show <form-id> --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := formID.Pick(cmd, &inputs.ID, cli.formPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var form *management.Form
+			if err := ansi.Waiting(func() (err error) {
+				form, err = cli.api.Form.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read form with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.FormShow(form)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func createFormCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Name       string
+		Definition string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Args:  cobra.NoArgs,
+		Short: "Create a new form",
+		Long: "Create a new form.\n\n" +
+			"To create interactively, use `auth0 forms create` with no flags. " +
+			"The definition of the form will be opened as JSON in your default editor.\n\n" +
+			"To create non-interactively, supply the name and the definition through the flags.",
+		Example: `  auth0 forms create
+  auth0 forms create --name "Progressive Profile"
+  auth0 forms create -n "Progressive Profile" --definition "$(cat form.json)"
+  auth0 forms create -n "Progressive Profile" --definition "$(cat form.json)" --json
+  auth0 forms create -n "Progressive Profile" --definition "$(cat form.json)" --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := formName.Ask(cmd, &inputs.Name, nil); err != nil {
+				return err
+			}
+
+			if err := formContentFlag.OpenEditor(
+				cmd,
+				&inputs.Definition,
+				defaultFormContent,
+				"form.*.json",
+				cli.formEditorHint,
+			); err != nil {
+				return fmt.Errorf("failed to capture input from the editor: %w", err)
+			}
+
+			form := &management.Form{
+				Name: &inputs.Name,
+			}
+
+			if inputs.Definition != "" {
+				var content formContent
+				if err := decodeDefinition([]byte(inputs.Definition), &content); err != nil {
+					return fmt.Errorf("invalid JSON input for the definition: %w", err)
+				}
+				content.applyTo(form)
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Form.Create(cmd.Context(), form)
+			}); err != nil {
+				return fmt.Errorf("failed to create form: %w", err)
+			}
+
+			cli.renderer.FormCreate(form)
+			return nil
+		},
+	}
+
+	formName.RegisterString(cmd, &inputs.Name, "")
+	formContentFlag.RegisterString(cmd, &inputs.Definition, "")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func updateFormCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID         string
+		Name       string
+		Definition string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Update a form",
+		Long: "Update a form.\n\n" +
+			"To update interactively, use `auth0 forms update` with no arguments. " +
+			"The current definition of the form will be opened as JSON in your default editor.\n\n" +
+			"To update non-interactively, supply the form id and the settings to change through the flags.",
+		Example: `  auth0 forms update
+  auth0 forms update <form-id>
+  auth0 forms update <form-id> --name "Progressive Profile"
+  auth0 forms update <form-id> --definition "$(cat form.json)"
+  auth0 forms update <form-id> -n "Progressive Profile" --definition "$(cat form.json)" --json
+  auth0 forms update <form-id> -n "Progressive Profile" --definition "$(cat form.json)" --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := formID.Pick(cmd, &inputs.ID, cli.formPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var current *management.Form
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.api.Form.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read form with ID %q: %w", inputs.ID, err)
+			}
+
+			if noLocalFlagSet(cmd) {
+				if err := formName.AskU(cmd, &inputs.Name, current.Name); err != nil {
+					return err
+				}
+
+				currentContent, err := json.MarshalIndent(newFormContent(current), "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to serialize the definition of form with ID %q: %w", inputs.ID, err)
+				}
+
+				if err := formContentFlag.OpenEditorU(
+					cmd,
+					&inputs.Definition,
+					string(currentContent),
+					current.GetName()+".*.json",
+				); err != nil {
+					return fmt.Errorf("failed to capture input from the editor: %w", err)
+				}
+			}
+
+			update := &management.Form{}
+			if inputs.Name != "" {
+				update.Name = &inputs.Name
+			}
+			if inputs.Definition != "" {
+				var content formContent
+				if err := decodeDefinition([]byte(inputs.Definition), &content); err != nil {
+					return fmt.Errorf("invalid JSON input for the definition: %w", err)
+				}
+				content.applyTo(update)
+			}
+
+			var updated *management.Form
+			if err := ansi.Waiting(func() (err error) {
+				if err = cli.api.Form.Update(cmd.Context(), inputs.ID, update); err != nil {
+					return err
+				}
+				updated, err = cli.api.Form.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to update form with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.FormUpdate(updated)
+			return nil
+		},
+	}
+
+	formName.RegisterStringU(cmd, &inputs.Name, "")
+	formContentFlag.RegisterStringU(cmd, &inputs.Definition, "")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func deleteFormCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a form",
+		Long: "Delete a form.\n\n" +
+			"To delete interactively, use `auth0 forms delete` with no arguments.\n\n" +
+			"To delete non-interactively, supply the form id and the `--force` flag to skip confirmation.",
+		Example: `  auth0 forms delete
+  auth0 forms rm
+  auth0 forms delete <form-id>
+  auth0 forms delete <form-id> --force
+  auth0 forms delete <form-id> <form-id2> <form-idn>
+  auth0 forms delete <form-id> <form-id2> <form-idn> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var ids []string
+			if len(args) == 0 {
+				if err := formID.PickMany(cmd, &ids, cli.formPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				ids = args
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			return ansi.ProgressBar("Deleting form(s)", ids, func(_ int, id string) error {
+				if id != "" {
+					if err := cli.api.Form.Delete(cmd.Context(), id); err != nil {
+						return fmt.Errorf("failed to delete form with ID %q: %w", id, err)
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func pullFormsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Dir string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "pull",
+		Args:  cobra.ArbitraryArgs,
+		Short: "Pull the definitions of forms to local files",
+		Long: "Pull the definitions of forms to JSON files in a directory, one file per form. " +
+			"All forms are pulled unless form ids are given.\n\n" +
+			"Files are named after the forms. A form that was pulled before is written to the same file, even if it was renamed. " +
+			"Use `auth0 forms push` to apply the files to the tenant.",
+		Example: `  auth0 forms pull --dir ./forms
+  auth0 forms pull <form-id> --dir ./forms
+  auth0 forms pull <form-id> <form-id2> -d ./forms --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return pullDefinitions(cmd.Context(), cli, cli.formDefinitions(), inputs.Dir, args)
+		},
+	}
+
+	formDir.RegisterString(cmd, &inputs.Dir, "")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func pushFormsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Dir string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "push",
+		Args:  cobra.NoArgs,
+		Short: "Push the definitions of forms from local files",
+		Long: "Push the definitions of forms from the JSON files of a directory to the tenant.\n\n" +
+			"Forms are matched by the id of their file, or by name when the tenant has no form with that id, " +
+			"such as when pushing files pulled from another tenant. Files without an id create a new form, " +
+			"and the id of the created form is written back to the file. Forms without a file are left untouched.\n\n" +
+			"The flows run by the forms aren't matched by name: as flow ids differ from one tenant to another, " +
+			"push the flows first with `auth0 flows push` and set the `flow_id` of the nodes to the ids of the pushed flows. " +
+			"Nothing is pushed while a form runs a flow that the tenant doesn't have.",
+		Example: `  auth0 forms push --dir ./forms
+  auth0 forms push -d ./forms --json
+  auth0 forms push -d ./forms --tenant prod.auth0.com`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return pushDefinitions(cmd.Context(), cli, cli.formDefinitions(), inputs.Dir)
+		},
+	}
+
+	formDir.RegisterString(cmd, &inputs.Dir, "")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func (c *cli) getForms(ctx context.Context, number int) ([]*management.Form, error) {
+	list, err := getWithPagination(
+		number,
+		func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+			forms, err := c.api.Form.List(ctx, opts...)
+			if err != nil {
+				return nil, false, err
+			}
+
+			for _, form := range forms.Forms {
+				result = append(result, form)
+			}
+
+			return result, forms.HasNext(), nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list forms: %w", err)
+	}
+
+	var forms []*management.Form
+	for _, item := range list {
+		forms = append(forms, item.(*management.Form))
+	}
+
+	return forms, nil
+}
+
+// getAllForms lists every form, without the spinner of getWithPagination.
+func (c *cli) getAllForms(ctx context.Context) ([]*management.Form, error) {
+	var forms []*management.Form
+	for page := 0; ; page++ {
+		list, err := c.api.Form.List(ctx, management.PerPage(defaultPageSize), management.Page(page))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list forms: %w", err)
+		}
+
+		forms = append(forms, list.Forms...)
+
+		if !list.HasNext() {
+			return forms, nil
+		}
+	}
+}
+
+// formDefinitions is the resource of the pull and push commands of forms.
+func (c *cli) formDefinitions() definitionResource[*formDefinition] {
+	// The IDs of the flows of the tenant, listed when the first form that runs a flow is checked.
+	var flowIDs map[string]bool
+
+	return definitionResource[*formDefinition]{
+		singular: "form",
+		plural:   "forms",
+		list: func(ctx context.Context) ([]definitionHeader, error) {
+			forms, err := c.getAllForms(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			headers := make([]definitionHeader, 0, len(forms))
+			for _, form := range forms {
+				headers = append(headers, definitionHeader{ID: form.GetID(), Name: form.GetName()})
+			}
+			return headers, nil
+		},
+		read: func(ctx context.Context, id string) (*formDefinition, error) {
+			form, err := c.api.Form.Read(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			return &formDefinition{
+				definitionHeader: definitionHeader{ID: form.GetID(), Name: form.GetName()},
+				formContent:      newFormContent(form),
+			}, nil
+		},
+		empty: func() *formDefinition {
+			return &formDefinition{}
+		},
+		check: func(ctx context.Context, path string, definition *formDefinition) error {
+			for _, id := range definition.flowIDs() {
+				if flowIDs == nil {
+					flows, err := c.getAllFlows(ctx)
+					if err != nil {
+						return err
+					}
+
+					flowIDs = make(map[string]bool, len(flows))
+					for _, flow := range flows {
+						flowIDs[flow.GetID()] = true
+					}
+				}
+
+				if !flowIDs[id] {
+					return fmt.Errorf(
+						"form %q of %q runs the flow with ID %q, which the tenant doesn't have: "+
+							"push the flows first and set the \"flow_id\" of the node to the ID of the pushed flow",
+						definition.Name, path, id,
+					)
+				}
+			}
+
+			return nil
+		},
+		create: func(ctx context.Context, definition *formDefinition) (string, error) {
+			form := definition.form()
+			if err := c.api.Form.Create(ctx, form); err != nil {
+				return "", err
+			}
+
+			return form.GetID(), nil
+		},
+		update: func(ctx context.Context, id string, definition *formDefinition) error {
+			return c.api.Form.Update(ctx, id, definition.form())
+		},
+	}
+}
+
+func (c *cli) formPickerOptions(ctx context.Context) (pickerOptions, error) {
+	forms, err := c.getAllForms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, form := range forms {
+		value := form.GetID()
+		label := fmt.Sprintf("%s %s", form.GetName(), ansi.Faint("("+value+")"))
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no forms to choose from. Create one by running: `auth0 forms create`")
+	}
+
+	return opts, nil
+}
+
+func (c *cli) formEditorHint() {
+	c.renderer.Infof("%s Once you close the editor, the form will be created. To cancel, press CTRL+C.", ansi.Faint("Hint:"))
+}
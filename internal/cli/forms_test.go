@@ -0,0 +1,223 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestFormsPullCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+
+	// The form was pulled before under its previous name.
+	previousPath := filepath.Join(dir, "profile.json")
+	require.NoError(t, os.WriteFile(previousPath, []byte(`{"id": "ap_1", "name": "Profile"}`), 0600))
+
+	formAPI := mock.NewMockFormAPI(ctrl)
+	formAPI.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(&management.FormList{Forms: []*management.Form{
+			{ID: auth0.String("ap_1"), Name: auth0.String("Progressive Profile")},
+			{ID: auth0.String("ap_2"), Name: auth0.String("Terms")},
+		}}, nil)
+	formAPI.EXPECT().
+		Read(gomock.Any(), "ap_1").
+		Return(&management.Form{
+			ID:    auth0.String("ap_1"),
+			Name:  auth0.String("Progressive Profile"),
+			Nodes: []interface{}{map[string]interface{}{"id": "step_1", "type": "STEP"}},
+		}, nil)
+	formAPI.EXPECT().
+		Read(gomock.Any(), "ap_2").
+		Return(&management.Form{ID: auth0.String("ap_2"), Name: auth0.String("Terms")}, nil)
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Form: formAPI},
+	}
+
+	cmd := pullFormsCmd(cli)
+	cmd.SetArgs([]string{"--dir", dir})
+	require.NoError(t, cmd.Execute())
+
+	var pulled formDefinition
+	require.NoError(t, readDefinitionFile(previousPath, &pulled))
+	assert.Equal(t, "ap_1", pulled.ID)
+	assert.Equal(t, "Progressive Profile", pulled.Name)
+	assert.Len(t, pulled.Nodes, 1)
+
+	var created formDefinition
+	require.NoError(t, readDefinitionFile(filepath.Join(dir, "terms.json"), &created))
+	assert.Equal(t, "ap_2", created.ID)
+}
+
+func TestFormsPushCmd(t *testing.T) {
+	t.Run("it updates existing forms and creates new ones", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(`{"id": "ap_1", "name": "Progressive Profile", "nodes": []}`), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.json"), []byte(`{"name": "Terms", "languages": {"primary": "en"}}`), 0600))
+
+		formAPI := mock.NewMockFormAPI(ctrl)
+		formAPI.EXPECT().
+			Update(gomock.Any(), "ap_1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, form *management.Form, _ ...management.RequestOption) error {
+				assert.Equal(t, "Progressive Profile", form.GetName())
+				return nil
+			})
+		formAPI.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, form *management.Form, _ ...management.RequestOption) error {
+				assert.Equal(t, "en", form.GetLanguages().GetPrimary())
+				form.ID = auth0.String("ap_2")
+				return nil
+			})
+
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			api:      &auth0.API{Form: formAPI},
+		}
+
+		cmd := pushFormsCmd(cli)
+		cmd.SetArgs([]string{"--dir", dir})
+		require.NoError(t, cmd.Execute())
+
+		// The ID of the created form is written back to its file.
+		content, err := os.ReadFile(filepath.Join(dir, "b.json"))
+		require.NoError(t, err)
+
+		var definition map[string]interface{}
+		require.NoError(t, json.Unmarshal(content, &definition))
+		assert.Equal(t, "ap_2", definition["id"])
+		assert.Equal(t, "Terms", definition["name"])
+	})
+
+	t.Run("it matches forms pulled from another tenant by name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(`{"id": "ap_dev_1", "name": "Progressive Profile"}`), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.json"), []byte(`{"id": "ap_dev_2", "name": "Terms"}`), 0600))
+
+		notFound := testManagementError{message: "Not Found", status: 404}
+
+		formAPI := mock.NewMockFormAPI(ctrl)
+		formAPI.EXPECT().Update(gomock.Any(), "ap_dev_1", gomock.Any()).Return(notFound)
+		formAPI.EXPECT().Update(gomock.Any(), "ap_dev_2", gomock.Any()).Return(notFound)
+		formAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(&management.FormList{Forms: []*management.Form{
+				{ID: auth0.String("ap_prod_1"), Name: auth0.String("Progressive Profile")},
+			}}, nil)
+		formAPI.EXPECT().Update(gomock.Any(), "ap_prod_1", gomock.Any()).Return(nil)
+		formAPI.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, form *management.Form, _ ...management.RequestOption) error {
+				form.ID = auth0.String("ap_prod_2")
+				return nil
+			})
+
+		buf := &bytes.Buffer{}
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+			api:      &auth0.API{Form: formAPI},
+		}
+
+		cmd := pushFormsCmd(cli)
+		cmd.SetArgs([]string{"--dir", dir})
+		require.NoError(t, cmd.Execute())
+
+		assert.JSONEq(t, `[
+			{"id": "ap_prod_1", "name": "Progressive Profile", "path": "`+filepath.Join(dir, "a.json")+`", "action": "updated"},
+			{"id": "ap_prod_2", "name": "Terms", "path": "`+filepath.Join(dir, "b.json")+`", "action": "created"}
+		]`, buf.String())
+
+		// The files keep the IDs of the forms of the tenant they were pulled from.
+		var definition formDefinition
+		require.NoError(t, readDefinitionFile(filepath.Join(dir, "b.json"), &definition))
+		assert.Equal(t, "ap_dev_2", definition.ID)
+	})
+
+	t.Run("it lists the forms pushed before a failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(`{"id": "ap_1", "name": "Progressive Profile"}`), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.json"), []byte(`{"id": "ap_2", "name": "Terms"}`), 0600))
+
+		formAPI := mock.NewMockFormAPI(ctrl)
+		formAPI.EXPECT().Update(gomock.Any(), "ap_1", gomock.Any()).Return(nil)
+		formAPI.EXPECT().Update(gomock.Any(), "ap_2", gomock.Any()).Return(testManagementError{message: "Bad Request", status: 400})
+
+		buf := &bytes.Buffer{}
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+			api:      &auth0.API{Form: formAPI},
+		}
+
+		cmd := pushFormsCmd(cli)
+		cmd.SetArgs([]string{"--dir", dir})
+		assert.ErrorContains(t, cmd.Execute(), `failed to update form with ID "ap_2"`)
+
+		assert.JSONEq(t, `[{"id": "ap_1", "name": "Progressive Profile", "path": "`+filepath.Join(dir, "a.json")+`", "action": "updated"}]`, buf.String())
+	})
+
+	t.Run("it doesn't push anything when a form runs a flow that the tenant doesn't have", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(`{"id": "ap_1", "name": "Profile", "nodes": [
+			{"id": "flow_1", "type": "FLOW", "config": {"flow_id": "af_1"}}
+		]}`), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.json"), []byte(`{"id": "ap_2", "name": "Terms", "nodes": [
+			{"id": "flow_2", "type": "FLOW", "config": {"flow_id": "af_dev_2"}}
+		]}`), 0600))
+
+		flowAPI := mock.NewMockFlowAPI(ctrl)
+		flowAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(&management.FlowList{Flows: []*management.Flow{{ID: auth0.String("af_1"), Name: auth0.String("Sync Profile")}}}, nil)
+
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			api:      &auth0.API{Flow: flowAPI},
+		}
+
+		cmd := pushFormsCmd(cli)
+		cmd.SetArgs([]string{"--dir", dir})
+		assert.ErrorContains(t, cmd.Execute(), `form "Terms" of "`+filepath.Join(dir, "b.json")+`" runs the flow with ID "af_dev_2", which the tenant doesn't have`)
+	})
+
+	t.Run("it doesn't push anything when a file is invalid", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(`{"id": "ap_1", "name": "Profile"}`), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.json"), []byte(`{"name": "Terms", "node": []}`), 0600))
+
+		cli := &cli{renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard}}
+
+		cmd := pushFormsCmd(cli)
+		cmd.SetArgs([]string{"--dir", dir})
+		assert.ErrorContains(t, cmd.Execute(), `unknown field "node"`)
+	})
+}
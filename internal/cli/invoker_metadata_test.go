@@ -153,7 +153,7 @@ func TestInvokerMetadataTransportSetsHeader(t *testing.T) {
 	t.Cleanup(testServer.Close)
 
 	metadata := invokerMetadata{InvokerKind: "agent", InvokerAgent: "claude-code"}.headerValue()
-	client := customClientWithRetries(metadata)
+	client := customClientWithRetries(metadata, retryPolicy{maxRetries: defaultMaxRetries}, http.DefaultTransport)
 
 	request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
 	require.NoError(t, err)
@@ -179,7 +179,7 @@ func TestInvokerMetadataTransportOmitsEmptyHeader(t *testing.T) {
 	request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
 	require.NoError(t, err)
 
-	response, err := customClientWithRetries("").Do(request)
+	response, err := customClientWithRetries("", retryPolicy{maxRetries: defaultMaxRetries}, http.DefaultTransport).Do(request)
 	require.NoError(t, err)
 	t.Cleanup(func() {
 		require.NoError(t, response.Body.Close())
@@ -272,7 +272,7 @@ func TestManagementClientV1SendsInvokerMetadata(t *testing.T) {
 
 	metadata := invokerMetadata{InvokerKind: "agent", InvokerAgent: "claude-code", CI: true}
 
-	api, err := initializeManagementClient(domainOf(server), "test-token", metadata.headerValue())
+	api, err := initializeManagementClient(domainOf(server), "test-token", metadata.headerValue(), retryPolicy{maxRetries: defaultMaxRetries}, http.DefaultTransport)
 	require.NoError(t, err)
 
 	_, err = api.ResourceServer.List(t.Context())
@@ -293,7 +293,7 @@ func TestManagementClientV3SendsInvokerMetadata(t *testing.T) {
 
 	metadata := invokerMetadata{InvokerKind: "human", InvokerAgent: "unknown", CI: false}
 
-	api, err := initializeManagementClientV3(domainOf(server), "test-token", metadata.headerValue())
+	api, err := initializeManagementClientV3(domainOf(server), "test-token", metadata.headerValue(), retryPolicy{maxRetries: defaultMaxRetries}, http.DefaultTransport)
 	require.NoError(t, err)
 
 	_, err = api.ClientGrants.List(t.Context(), &management.ListClientGrantsRequestParameters{})
@@ -329,7 +329,7 @@ func TestManagementClientSendsMetadataOnRetries(t *testing.T) {
 
 	metadata := invokerMetadata{InvokerKind: "agent", InvokerAgent: "cursor", CI: false}
 
-	api, err := initializeManagementClient(domainOf(server), "test-token", metadata.headerValue())
+	api, err := initializeManagementClient(domainOf(server), "test-token", metadata.headerValue(), retryPolicy{maxRetries: defaultMaxRetries}, http.DefaultTransport)
 	require.NoError(t, err)
 
 	_, err = api.ResourceServer.List(t.Context())
@@ -3,10 +3,13 @@ package cli
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
 
 	"github.com/auth0/go-auth0/management"
 	"github.com/spf13/cobra"
@@ -50,6 +53,9 @@ func logsCmd(cli *cli) *cobra.Command {
 	cmd.SetUsageTemplate(resourceUsageTemplate())
 	cmd.AddCommand(listLogsCmd(cli))
 	cmd.AddCommand(tailLogsCmd(cli))
+	cmd.AddCommand(exportLogsCmd(cli))
+	cmd.AddCommand(statsLogsCmd(cli))
+	cmd.AddCommand(watchLogsCmd(cli))
 	cmd.AddCommand(logStreamsCmd(cli))
 
 	return cmd
@@ -132,15 +138,23 @@ func listLogsCmd(cli *cli) *cobra.Command {
 
 func tailLogsCmd(cli *cli) *cobra.Command {
 	var inputs struct {
-		Filter string
-		Num    int
+		Filter     string
+		Num        int
+		Output     string
+		Types      []string
+		Categories []string
+		Exec       string
+		Webhook    string
+		Interval   time.Duration
 	}
 
 	cmd := &cobra.Command{
 		Use:   "tail",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Tail the tenant logs",
-		Long:  "Tail the tenant logs allowing to filter using Lucene query syntax.",
+		Long: "Tail the tenant logs allowing to filter using Lucene query syntax.\n\n" +
+			"Logs can also be filtered client-side by type or category, printed as NDJSON, piped to a command " +
+			"with `--exec` or forwarded to a local service with `--webhook`, to feed local tooling during an incident.",
 		Example: `  auth0 logs tail
   auth0 logs tail --filter "client_id:<client-id>"
   auth0 logs tail --filter "client_name:<client-name>"
@@ -148,11 +162,39 @@ func tailLogsCmd(cli *cli) *cobra.Command {
   auth0 logs tail --filter "user_name:<user-name>"
   auth0 logs tail --filter "ip:<ip>"
   auth0 logs tail --filter "type:f" # See the full list of type codes at https://auth0.com/docs/logs/log-event-type-codes
-  auth0 logs tail -n 10`,
+  auth0 logs tail -n 10
+  auth0 logs tail --category failure
+  auth0 logs tail --type f,fp,fu --output ndjson
+  auth0 logs tail --category failure --exec "jq -r .description"
+  auth0 logs tail --webhook http://localhost:9000
+  auth0 logs tail --interval 10s`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if inputs.Num < 1 || inputs.Num > 1000 {
 				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
 			}
+			if inputs.Output != logTailOutputTable && inputs.Output != logTailOutputNDJSON {
+				return fmt.Errorf("invalid output %q, please use 'table' or 'ndjson'", inputs.Output)
+			}
+			for _, category := range inputs.Categories {
+				if !slices.Contains(display.LogCategories, category) {
+					return fmt.Errorf("invalid category %q, please use one of: %s", category, strings.Join(display.LogCategories, ", "))
+				}
+			}
+			pollOptions := logPollOptions{Filter: inputs.Filter, Interval: inputs.Interval}
+			if err := pollOptions.validate(); err != nil {
+				return err
+			}
+
+			filter := logTailFilter{types: inputs.Types, categories: inputs.Categories}
+
+			var sinks []logTailSink
+			if inputs.Exec != "" {
+				sinks = append(sinks, &execLogTailSink{command: inputs.Exec})
+			}
+			if inputs.Webhook != "" {
+				sinks = append(sinks, newWebhookLogTailSink(inputs.Webhook))
+			}
+
 			list, err := getLatestLogs(cmd.Context(), cli, inputs.Num, inputs.Filter)
 			if err != nil {
 				return fmt.Errorf("failed to list logs: %w", err)
@@ -165,45 +207,32 @@ func tailLogsCmd(cli *cli) *cobra.Command {
 				lastLogID = list[0].GetLogID()
 			}
 
+			forwarder := cli.newLogTailForwarder(cmd.Context(), sinks)
+			defer forwarder.close()
+
 			// Create a `set` to detect duplicates clientside.
 			set := make(map[string]struct{})
-			list = dedupeLogs(list, set)
+			list = filter.apply(dedupeLogs(list, set))
+			forwarder.forward(list)
 
-			go func(lastLogID string) {
+			go func() {
 				defer close(logsCh)
 
-				for {
-					queryParams := []management.RequestOption{
-						management.Parameter("page", "0"),
-						management.Parameter("per_page", "100"),
-						management.Parameter("sort", "date:-1"),
-					}
-
-					if lastLogID != "" {
-						queryParams = append(queryParams, management.Query(fmt.Sprintf("log_id:[%s TO *]", lastLogID)))
-					}
-
-					if inputs.Filter != "" {
-						queryParams = append(queryParams, management.Query(inputs.Filter))
-					}
-
-					list, err := cli.api.Log.List(cmd.Context(), queryParams...)
-					if err != nil {
-						cli.renderer.Errorf("Failed to get latest logs: %v", err)
-						return
-					}
-
-					if len(list) > 1 {
-						logsCh <- dedupeLogs(list, set)
-						lastLogID = list[0].GetLogID()
-					}
-
-					if len(list) < logsPerPageLimit {
-						// Not a lot is happening, sleep on it.
-						time.Sleep(2 * time.Second)
-					}
+				cli.pollLogs(cmd.Context(), lastLogID, set, pollOptions, func(logs []*management.Log) {
+					logs = filter.apply(logs)
+					forwarder.forward(logs)
+					logsCh <- logs
+				})
+			}()
+
+			if inputs.Output == logTailOutputNDJSON {
+				output := []logTailSink{newNDJSONLogTailSink(cli.renderer.ResultWriter)}
+				cli.sendLogsToSinks(cmd.Context(), list, output)
+				for logs := range logsCh {
+					cli.sendLogsToSinks(cmd.Context(), logs, output)
 				}
-			}(lastLogID)
+				return nil
+			}
 
 			cli.renderer.LogTail(list, logsCh, !cli.debug)
 			return nil
@@ -212,6 +241,12 @@ func tailLogsCmd(cli *cli) *cobra.Command {
 
 	logsFilter.RegisterString(cmd, &inputs.Filter, "")
 	logsNum.RegisterInt(cmd, &inputs.Num, defaultPageSize)
+	logsTailOutput.RegisterString(cmd, &inputs.Output, logTailOutputTable)
+	logsTailType.RegisterStringSlice(cmd, &inputs.Types, nil)
+	logsTailCategory.RegisterStringSlice(cmd, &inputs.Categories, nil)
+	logsTailExec.RegisterString(cmd, &inputs.Exec, "")
+	logsTailWebhook.RegisterString(cmd, &inputs.Webhook, "")
+	logsTailInterval.RegisterDuration(cmd, &inputs.Interval, 2*time.Second)
 
 	return cmd
 }
@@ -242,7 +277,7 @@ func getLatestLogs(ctx context.Context, cli *cli, numRequested int, filter strin
 		logs = append(logs, res...)
 
 		page++
-		if page == 10 || (page*logsPerPageLimit) >= numRequested {
+		if page == 10 || (page*logsPerPageLimit) >= numRequested || len(res) < perPage {
 			break
 		}
 	}
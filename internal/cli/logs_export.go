@@ -0,0 +1,355 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const (
+	logExportFormatNDJSON = "ndjson"
+	logExportFormatCSV    = "csv"
+)
+
+var (
+	logsExportFrom = Flag{
+		Name:     "From",
+		LongForm: "from",
+		Help: "Export the logs from this date, formatted as YYYY-MM-DD or RFC 3339. " +
+			"Required unless resuming from a state file.",
+	}
+
+	logsExportTo = Flag{
+		Name:     "To",
+		LongForm: "to",
+		Help:     "Export the logs until this date, excluded, formatted as YYYY-MM-DD or RFC 3339. Defaults to now.",
+	}
+
+	logsExportOutput = Flag{
+		Name:      "Output",
+		LongForm:  "output",
+		ShortForm: "o",
+		Help:      "File to write the logs to. Logs are written to the standard output if omitted.",
+	}
+
+	logsExportFormat = Flag{
+		Name:     "Format",
+		LongForm: "format",
+		Help:     "Format of the exported logs. Options include: 'ndjson' and 'csv'.",
+	}
+
+	logsExportStateFile = Flag{
+		Name:     "State File",
+		LongForm: "state-file",
+		Help: "File keeping track of the last exported log. If it exists, the export resumes after that log " +
+			"and appends to the output file.",
+	}
+)
+
+// logExportState is persisted in the state file so that an export can be resumed.
+type logExportState struct {
+	LastLogID string    `json:"last_log_id"`
+	To        time.Time `json:"to"`
+}
+
+type logExportWriter interface {
+	Write(logs []*management.Log) error
+}
+
+type ndjsonLogExportWriter struct {
+	encoder *json.Encoder
+}
+
+type csvLogExportWriter struct {
+	writer *csv.Writer
+}
+
+func exportLogsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		From      string
+		To        string
+		Output    string
+		Format    string
+		StateFile string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Args:  cobra.NoArgs,
+		Short: "Export the tenant logs",
+		Long: "Export every log of a time window, for instance to investigate an incident.\n\n" +
+			"Logs are fetched with checkpoint pagination, so the export is not limited by the number of results " +
+			"of a search. Rate limited requests are retried like every Management API request, see `--max-retries`.\n\n" +
+			"Use `--state-file` to keep track of the last exported log, so that an interrupted export can be resumed " +
+			"by running the same command again.",
+		Example: `  auth0 logs export --from 2026-10-01
+  auth0 logs export --from 2026-10-01 --to 2026-10-02 --output logs.ndjson
+  auth0 logs export --from 2026-10-01T08:00:00Z --to 2026-10-01T09:00:00Z -o logs.csv --format csv
+  auth0 logs export --from 2026-10-01 --to 2026-10-02 -o logs.ndjson --state-file logs.state`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Format != logExportFormatNDJSON && inputs.Format != logExportFormatCSV {
+				return fmt.Errorf("invalid format %q, please use 'ndjson' or 'csv'", inputs.Format)
+			}
+
+			state, resuming, err := readLogExportState(inputs.StateFile)
+			if err != nil {
+				return err
+			}
+
+			var from time.Time
+			if !resuming {
+				if inputs.From == "" {
+					return errors.New("the --from flag is required unless resuming from a state file")
+				}
+
+				if from, err = parseLogExportDate(inputs.From); err != nil {
+					return fmt.Errorf("invalid --from date: %w", err)
+				}
+
+				state.To = time.Now().UTC()
+				if inputs.To != "" {
+					if state.To, err = parseLogExportDate(inputs.To); err != nil {
+						return fmt.Errorf("invalid --to date: %w", err)
+					}
+				}
+
+				if !from.Before(state.To) {
+					return errors.New("the --from date must be before the --to date")
+				}
+			}
+
+			output, err := openLogExportOutput(inputs.Output, resuming)
+			if err != nil {
+				return err
+			}
+			defer output.Close()
+
+			writer := newLogExportWriter(output, inputs.Format, !resuming || inputs.Output == "")
+
+			var exported int
+			if err := ansi.Spinner("Exporting logs", func() (err error) {
+				exported, err = cli.exportLogs(cmd.Context(), from, &state, writer, func(state logExportState) error {
+					return writeLogExportState(inputs.StateFile, state)
+				})
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to export logs: %w", err)
+			}
+
+			destination := "the standard output"
+			if inputs.Output != "" {
+				destination = inputs.Output
+			}
+			cli.renderer.Infof("Exported %d logs to %s", exported, ansi.Bold(destination))
+
+			return nil
+		},
+	}
+
+	logsExportFrom.RegisterString(cmd, &inputs.From, "")
+	logsExportTo.RegisterString(cmd, &inputs.To, "")
+	logsExportOutput.RegisterString(cmd, &inputs.Output, "")
+	logsExportFormat.RegisterString(cmd, &inputs.Format, logExportFormatNDJSON)
+	logsExportStateFile.RegisterString(cmd, &inputs.StateFile, "")
+
+	return cmd
+}
+
+// exportLogs writes the logs dated from the given date, or following the last exported
+// log when resuming, up to the end date of the state. The state is saved after each page.
+func (c *cli) exportLogs(
+	ctx context.Context,
+	from time.Time,
+	state *logExportState,
+	writer logExportWriter,
+	saveState func(logExportState) error,
+) (int, error) {
+	var exported int
+
+	if state.LastLogID == "" {
+		// Checkpoint pagination can't filter by date, so the
+		// first log of the window is found with a search.
+		first, err := c.api.Log.List(
+			ctx,
+			management.Query(fmt.Sprintf("date:[%s TO %s]", from.Format(time.RFC3339), state.To.Format(time.RFC3339))),
+			management.Parameter("sort", "date:1"),
+			management.Parameter("page", "0"),
+			management.Parameter("per_page", "1"),
+		)
+		if err != nil {
+			return 0, err
+		}
+		if len(first) == 0 || !first[0].GetDate().Before(state.To) {
+			return 0, nil
+		}
+
+		if err := writer.Write(first); err != nil {
+			return 0, err
+		}
+		exported++
+
+		state.LastLogID = first[0].GetLogID()
+		if err := saveState(*state); err != nil {
+			return exported, err
+		}
+	}
+
+	for {
+		list, err := c.api.Log.List(
+			ctx,
+			management.Parameter("from", state.LastLogID),
+			management.Parameter("take", strconv.Itoa(logsPerPageLimit)),
+		)
+		if err != nil {
+			return exported, err
+		}
+
+		var page []*management.Log
+		done := len(list) == 0
+		for _, log := range list {
+			if log.GetLogID() == state.LastLogID {
+				continue
+			}
+			if !log.GetDate().Before(state.To) {
+				done = true
+				break
+			}
+			page = append(page, log)
+		}
+
+		if len(page) > 0 {
+			if err := writer.Write(page); err != nil {
+				return exported, err
+			}
+			exported += len(page)
+
+			state.LastLogID = page[len(page)-1].GetLogID()
+			if err := saveState(*state); err != nil {
+				return exported, err
+			}
+		}
+
+		if done || len(page) == 0 {
+			return exported, nil
+		}
+	}
+}
+
+func parseLogExportDate(value string) (time.Time, error) {
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return date, nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither formatted as YYYY-MM-DD nor RFC 3339", value)
+	}
+
+	return date.UTC(), nil
+}
+
+// readLogExportState reads the state of a previous export. It reports
+// whether the export must be resumed, which is the case if a log was exported.
+func readLogExportState(path string) (logExportState, bool, error) {
+	var state logExportState
+	if path == "" {
+		return state, false, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return state, false, nil
+		}
+		return state, false, fmt.Errorf("failed to read state file %q: %w", path, err)
+	}
+
+	if err := json.Unmarshal(content, &state); err != nil {
+		return state, false, fmt.Errorf("failed to parse state file %q: %w", path, err)
+	}
+
+	return state, state.LastLogID != "", nil
+}
+
+func writeLogExportState(path string, state logExportState) error {
+	if path == "" {
+		return nil
+	}
+
+	content, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0600)
+}
+
+func openLogExportOutput(path string, appending bool) (io.WriteCloser, error) {
+	if path == "" {
+		return nopWriteCloser{os.Stdout}, nil
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if appending {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+
+	file, err := os.OpenFile(path, flags, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open output file %q: %w", path, err)
+	}
+
+	return file, nil
+}
+
+func newLogExportWriter(w io.Writer, format string, withHeader bool) logExportWriter {
+	if format == logExportFormatCSV {
+		writer := csv.NewWriter(w)
+		if withHeader {
+			_ = writer.Write((&display.LogView{}).AsCSVHeader())
+		}
+		return &csvLogExportWriter{writer: writer}
+	}
+
+	return &ndjsonLogExportWriter{encoder: json.NewEncoder(w)}
+}
+
+func (w *ndjsonLogExportWriter) Write(logs []*management.Log) error {
+	for _, log := range logs {
+		if err := w.encoder.Encode(log); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *csvLogExportWriter) Write(logs []*management.Log) error {
+	for _, log := range logs {
+		if err := w.writer.Write((&display.LogView{Log: log}).AsCSVRow()); err != nil {
+			return err
+		}
+	}
+
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
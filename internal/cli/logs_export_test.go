@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func TestExportLogs(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("it walks the logs with checkpoint pagination until the end date", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		gomock.InOrder(
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{
+					{LogID: auth0.String("log-1"), Date: auth0.Time(from.Add(time.Hour))},
+				}, nil),
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{
+					{LogID: auth0.String("log-1"), Date: auth0.Time(from.Add(time.Hour))},
+					{LogID: auth0.String("log-2"), Date: auth0.Time(from.Add(2 * time.Hour))},
+				}, nil),
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{
+					{LogID: auth0.String("log-2"), Date: auth0.Time(from.Add(2 * time.Hour))},
+					{LogID: auth0.String("log-3"), Date: auth0.Time(to.Add(-time.Minute))},
+					{LogID: auth0.String("log-4"), Date: auth0.Time(to)},
+				}, nil),
+		)
+
+		cli := &cli{api: &auth0.API{Log: logAPI}}
+
+		var output bytes.Buffer
+		var saved []string
+		state := logExportState{To: to}
+
+		exported, err := cli.exportLogs(
+			context.Background(),
+			from,
+			&state,
+			newLogExportWriter(&output, logExportFormatNDJSON, true),
+			func(state logExportState) error {
+				saved = append(saved, state.LastLogID)
+				return nil
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, exported)
+		assert.Equal(t, []string{"log-1", "log-2", "log-3"}, saved)
+		assert.Equal(t, "log-3", state.LastLogID)
+
+		lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.Contains(t, lines[0], `"log_id":"log-1"`)
+		assert.Contains(t, lines[2], `"log_id":"log-3"`)
+	})
+
+	t.Run("it resumes after the last exported log", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		gomock.InOrder(
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{
+					{LogID: auth0.String("log-2"), Date: auth0.Time(from.Add(2 * time.Hour))},
+					{LogID: auth0.String("log-3"), Date: auth0.Time(from.Add(3 * time.Hour))},
+				}, nil),
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{
+					{LogID: auth0.String("log-3"), Date: auth0.Time(from.Add(3 * time.Hour))},
+				}, nil),
+		)
+
+		cli := &cli{api: &auth0.API{Log: logAPI}}
+
+		var output bytes.Buffer
+		state := logExportState{LastLogID: "log-2", To: to}
+
+		exported, err := cli.exportLogs(
+			context.Background(),
+			time.Time{},
+			&state,
+			newLogExportWriter(&output, logExportFormatCSV, false),
+			func(logExportState) error { return nil },
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, exported)
+		assert.Equal(t, "log-3", state.LastLogID)
+		assert.True(t, strings.HasPrefix(output.String(), "log-3,"))
+	})
+
+	t.Run("it exports nothing when there are no logs in the time window", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		logAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*management.Log{}, nil)
+
+		cli := &cli{api: &auth0.API{Log: logAPI}}
+
+		state := logExportState{To: to}
+		exported, err := cli.exportLogs(
+			context.Background(),
+			from,
+			&state,
+			newLogExportWriter(&bytes.Buffer{}, logExportFormatNDJSON, true),
+			func(logExportState) error { return nil },
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, exported)
+		assert.Empty(t, state.LastLogID)
+	})
+
+	t.Run("it leaves retrying rate limited requests to the transport", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		logAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, testManagementError{message: "Too Many Requests", status: http.StatusTooManyRequests})
+
+		cli := &cli{api: &auth0.API{Log: logAPI}}
+
+		state := logExportState{LastLogID: "log-2", To: to}
+		_, err := cli.exportLogs(
+			context.Background(),
+			time.Time{},
+			&state,
+			newLogExportWriter(&bytes.Buffer{}, logExportFormatNDJSON, true),
+			func(logExportState) error { return nil },
+		)
+
+		assert.EqualError(t, err, "Too Many Requests")
+	})
+}
+
+func TestParseLogExportDate(t *testing.T) {
+	date, err := parseLogExportDate("2026-10-01")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), date)
+
+	date, err = parseLogExportDate("2026-10-01T10:00:00+02:00")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC), date)
+
+	_, err = parseLogExportDate("yesterday")
+	assert.EqualError(t, err, `"yesterday" is neither formatted as YYYY-MM-DD nor RFC 3339`)
+}
+
+func TestLogExportState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.state")
+
+	state, resuming, err := readLogExportState(path)
+	require.NoError(t, err)
+	assert.False(t, resuming)
+	assert.Empty(t, state.LastLogID)
+
+	to := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, writeLogExportState(path, logExportState{LastLogID: "log-1", To: to}))
+
+	state, resuming, err = readLogExportState(path)
+	require.NoError(t, err)
+	assert.True(t, resuming)
+	assert.Equal(t, logExportState{LastLogID: "log-1", To: to}, state)
+}
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+// logsSearchLimit is the maximum number of logs that can be paginated through with a search.
+const logsSearchLimit = 1000
+
+var (
+	logsStatsSince = Flag{
+		Name:     "Since",
+		LongForm: "since",
+		Help:     "Aggregate the logs of this period until now, such as 30m, 1h or 7d.",
+	}
+
+	logsStatsGroupBy = Flag{
+		Name:     "Group By",
+		LongForm: "group-by",
+		Help: "Comma-separated list of dimensions to group the logs by. Options include: " +
+			strings.Join(display.LogDimensions, ", ") + ".",
+	}
+
+	logsStatsBuckets = Flag{
+		Name:     "Buckets",
+		LongForm: "buckets",
+		Help:     "Number of time buckets shown in the trend of each group. Minimum 1, maximum 60.",
+	}
+
+	logsStatsNum = Flag{
+		Name:      "Number of Entries",
+		LongForm:  "number",
+		ShortForm: "n",
+		Help:      "Number of the latest log entries to aggregate. Minimum 1, maximum 1000.",
+	}
+)
+
+func statsLogsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Since   string
+		GroupBy []string
+		Filter  string
+		Buckets int
+		Num     int
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Args:  cobra.NoArgs,
+		Short: "Show statistics on the tenant logs",
+		Long: "Count the recent tenant logs grouped by one or more dimensions, such as the log type, the client " +
+			"or the IP address, for instance to investigate a spike of failed logins.\n\n" +
+			"Groups are ranked by number of logs, along with a trend showing how the logs are spread over the period.",
+		Example: `  auth0 logs stats
+  auth0 logs stats --since 30m
+  auth0 logs stats --since 1h --group-by type,client_name,ip
+  auth0 logs stats --since 7d --group-by category --buckets 7
+  auth0 logs stats --filter "type:f" --group-by ip,user_name
+  auth0 logs stats --group-by ip --json
+  auth0 logs stats --group-by ip --json-compact
+  auth0 logs stats --group-by ip --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			since, err := parseLogsStatsSince(inputs.Since)
+			if err != nil {
+				return err
+			}
+
+			for _, dimension := range inputs.GroupBy {
+				if !slices.Contains(display.LogDimensions, dimension) {
+					return fmt.Errorf(
+						"invalid dimension %q, please use one of: %s",
+						dimension,
+						strings.Join(display.LogDimensions, ", "),
+					)
+				}
+			}
+
+			if inputs.Buckets < 1 || inputs.Buckets > 60 {
+				return fmt.Errorf("buckets flag invalid, please pass a number between 1 and 60")
+			}
+
+			if inputs.Num < 1 || inputs.Num > logsSearchLimit {
+				return fmt.Errorf("number flag invalid, please pass a number between 1 and %d", logsSearchLimit)
+			}
+
+			to := time.Now().UTC()
+			from := to.Add(-since)
+
+			filter := fmt.Sprintf("date:[%s TO *]", from.Format(time.RFC3339))
+			if inputs.Filter != "" {
+				filter = fmt.Sprintf("%s AND (%s)", filter, inputs.Filter)
+			}
+
+			var logs []*management.Log
+			if err := ansi.Waiting(func() (err error) {
+				logs, err = getLatestLogs(cmd.Context(), cli, inputs.Num, filter)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to list logs: %w", err)
+			}
+
+			if len(logs) == inputs.Num {
+				// Only the latest logs were fetched, so the stats start from the oldest of them.
+				from = logs[len(logs)-1].GetDate()
+				cli.renderer.Warnf(
+					"Only the latest %d logs were aggregated, since %s. Narrow down the period with --since or --filter.",
+					inputs.Num,
+					from.Local().Format("Jan 02 15:04:05"),
+				)
+			}
+
+			cli.renderer.LogStats(display.AggregateLogStats(logs, inputs.GroupBy, from, to, inputs.Buckets))
+
+			return nil
+		},
+	}
+
+	logsStatsSince.RegisterString(cmd, &inputs.Since, "1h")
+	logsStatsGroupBy.RegisterStringSlice(cmd, &inputs.GroupBy, []string{"type"})
+	logsFilter.RegisterString(cmd, &inputs.Filter, "")
+	logsStatsBuckets.RegisterInt(cmd, &inputs.Buckets, 12)
+	logsStatsNum.RegisterInt(cmd, &inputs.Num, logsSearchLimit)
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	return cmd
+}
+
+// parseLogsStatsSince parses a Go duration, also accepting a number of days such as 7d.
+func parseLogsStatsSince(value string) (time.Duration, error) {
+	var (
+		since time.Duration
+		err   error
+	)
+
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		var n int
+		n, err = strconv.Atoi(days)
+		since = time.Duration(n) * 24 * time.Hour
+	} else {
+		since, err = time.ParseDuration(value)
+	}
+
+	if err != nil || since <= 0 {
+		return 0, fmt.Errorf("invalid --since value %q, please pass a period such as 30m, 1h or 7d", value)
+	}
+
+	return since, nil
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestStatsLogsCommand(t *testing.T) {
+	t.Run("it ranks the logs grouped by the given dimensions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		now := time.Now()
+		logAPI := mock.NewMockLogAPI(ctrl)
+		logAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*management.Log{
+				{Type: auth0.String("s"), ClientName: auth0.String("My App"), Date: auth0.Time(now.Add(-time.Minute))},
+				{Type: auth0.String("f"), ClientName: auth0.String("My App"), Date: auth0.Time(now.Add(-2 * time.Minute))},
+				{Type: auth0.String("f"), ClientName: auth0.String("My App"), Date: auth0.Time(now.Add(-3 * time.Minute))},
+			}, nil)
+
+		result := &bytes.Buffer{}
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: result},
+			api:      &auth0.API{Log: logAPI},
+		}
+
+		cmd := statsLogsCmd(cli)
+		cmd.SetArgs([]string{"--since", "1h", "--group-by", "type,client_name"})
+		err := cmd.Execute()
+
+		assert.NoError(t, err)
+		assert.Contains(t, result.String(), "COUNT")
+		assert.Regexp(t, `Failed Login\s+My App\s+2\s+66.7%`, result.String())
+		assert.Regexp(t, `Success Login\s+My App\s+1\s+33.3%`, result.String())
+	})
+
+	t.Run("it returns an error for an unknown dimension", func(t *testing.T) {
+		cmd := statsLogsCmd(&cli{})
+		cmd.SetArgs([]string{"--group-by", "country"})
+		err := cmd.Execute()
+
+		assert.ErrorContains(t, err, `invalid dimension "country"`)
+	})
+
+	t.Run("it returns an error for an invalid period", func(t *testing.T) {
+		cmd := statsLogsCmd(&cli{})
+		cmd.SetArgs([]string{"--since", "yesterday"})
+		err := cmd.Execute()
+
+		assert.EqualError(t, err, `invalid --since value "yesterday", please pass a period such as 30m, 1h or 7d`)
+	})
+}
+
+func TestParseLogsStatsSince(t *testing.T) {
+	since, err := parseLogsStatsSince("30m")
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, since)
+
+	since, err = parseLogsStatsSince("7d")
+	assert.NoError(t, err)
+	assert.Equal(t, 7*24*time.Hour, since)
+
+	_, err = parseLogsStatsSince("-1h")
+	assert.Error(t, err)
+}
@@ -0,0 +1,335 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const (
+	logTailOutputTable  = "table"
+	logTailOutputNDJSON = "ndjson"
+
+	// logTailForwarderBuffer is the number of batches of logs, or of alerts,
+	// waiting for the sinks, past which the following ones are dropped.
+	logTailForwarderBuffer = 100
+)
+
+var (
+	logsTailOutput = Flag{
+		Name:      "Output",
+		LongForm:  "output",
+		ShortForm: "o",
+		Help:      "Format of the tailed logs. Options include: 'table' and 'ndjson'.",
+	}
+
+	logsTailType = Flag{
+		Name:     "Type",
+		LongForm: "type",
+		Help: "Only show the logs of these types, such as f or fp. " +
+			"See the full list of type codes at https://auth0.com/docs/logs/log-event-type-codes",
+	}
+
+	logsTailCategory = Flag{
+		Name:     "Category",
+		LongForm: "category",
+		Help:     "Only show the logs of these categories. Options include: success, warning, failure and unknown.",
+	}
+
+	logsTailExec = Flag{
+		Name:     "Exec",
+		LongForm: "exec",
+		Help:     "Shell command to run for each log, which receives the log as JSON on its standard input.",
+	}
+
+	logsTailWebhook = Flag{
+		Name:     "Webhook",
+		LongForm: "webhook",
+		Help:     "URL to forward each log to, as a JSON POST request.",
+	}
+
+	logsTailInterval = Flag{
+		Name:     "Interval",
+		LongForm: "interval",
+		Help:     "Time to wait between two polls of the logs when there are no new logs.",
+	}
+)
+
+// logPollOptions configures how the logs are polled by pollLogs.
+type logPollOptions struct {
+	Filter   string
+	Interval time.Duration
+}
+
+// logTailFilter filters the tailed logs client-side,
+// on top of the Lucene query sent to the API.
+type logTailFilter struct {
+	types      []string
+	categories []string
+}
+
+// logTailSink receives each tailed log that matches the filters, or any other event sent as JSON.
+type logTailSink interface {
+	Send(ctx context.Context, event interface{}) error
+}
+
+type ndjsonLogTailSink struct {
+	encoder *json.Encoder
+}
+
+type execLogTailSink struct {
+	command string
+}
+
+type webhookLogTailSink struct {
+	url    string
+	client *http.Client
+}
+
+// logTailForwarder sends the logs, or the alerts raised over them, to the sinks
+// from its own goroutine, so that slow sinks don't hold back the polling of the logs.
+type logTailForwarder struct {
+	cli   *cli
+	ctx   context.Context
+	sinks []logTailSink
+	queue chan func()
+	done  chan struct{}
+}
+
+func (o logPollOptions) validate() error {
+	if o.Interval <= 0 {
+		return errors.New("the interval must be positive")
+	}
+
+	return nil
+}
+
+// pollLogs polls the logs following the given one and hands them over, deduplicated
+// and sorted by date, until the context is done or listing the logs fails. Rate limited
+// polls are retried by the transport of the Management API client, see --max-retries.
+func (c *cli) pollLogs(
+	ctx context.Context,
+	lastLogID string,
+	set map[string]struct{},
+	opts logPollOptions,
+	handle func(logs []*management.Log),
+) {
+	for {
+		queryParams := []management.RequestOption{
+			management.Parameter("page", "0"),
+			management.Parameter("per_page", "100"),
+			management.Parameter("sort", "date:-1"),
+		}
+
+		if lastLogID != "" {
+			queryParams = append(queryParams, management.Query(fmt.Sprintf("log_id:[%s TO *]", lastLogID)))
+		}
+
+		if opts.Filter != "" {
+			queryParams = append(queryParams, management.Query(opts.Filter))
+		}
+
+		list, err := c.api.Log.List(ctx, queryParams...)
+		if err != nil {
+			c.renderer.Errorf("Failed to get latest logs: %v", err)
+			return
+		}
+
+		if len(list) > 1 {
+			handle(dedupeLogs(list, set))
+			lastLogID = list[0].GetLogID()
+		}
+
+		if len(list) < logsPerPageLimit {
+			// Not a lot is happening, sleep on it.
+			if sleepContext(ctx, opts.Interval) != nil {
+				return
+			}
+		}
+	}
+}
+
+func (f logTailFilter) match(log *management.Log) bool {
+	if len(f.types) > 0 && !slices.Contains(f.types, log.GetType()) {
+		return false
+	}
+
+	if len(f.categories) > 0 && !slices.Contains(f.categories, display.LogCategory(log)) {
+		return false
+	}
+
+	return true
+}
+
+func (f logTailFilter) apply(logs []*management.Log) []*management.Log {
+	res := make([]*management.Log, 0, len(logs))
+	for _, log := range logs {
+		if f.match(log) {
+			res = append(res, log)
+		}
+	}
+
+	return res
+}
+
+// sendLogsToSinks sends the logs to every sink in order. A failing sink
+// doesn't stop the tail, so errors are only reported as warnings.
+func (c *cli) sendLogsToSinks(ctx context.Context, logs []*management.Log, sinks []logTailSink) {
+	for _, log := range logs {
+		for _, sink := range sinks {
+			if err := sink.Send(ctx, log); err != nil {
+				c.renderer.Warnf("Failed to forward log %s: %v", log.GetLogID(), err)
+			}
+		}
+	}
+}
+
+func (c *cli) newLogTailForwarder(ctx context.Context, sinks []logTailSink) *logTailForwarder {
+	f := &logTailForwarder{
+		cli:   c,
+		ctx:   ctx,
+		sinks: sinks,
+		queue: make(chan func(), logTailForwarderBuffer),
+		done:  make(chan struct{}),
+	}
+
+	go func() {
+		defer close(f.done)
+
+		for send := range f.queue {
+			send()
+		}
+	}()
+
+	return f
+}
+
+// forward queues the logs for the sinks. The logs are dropped
+// with a warning if the sinks fell too far behind.
+func (f *logTailForwarder) forward(logs []*management.Log) {
+	if len(logs) == 0 {
+		return
+	}
+
+	if !f.enqueue(func() { f.cli.sendLogsToSinks(f.ctx, logs, f.sinks) }) {
+		f.cli.renderer.Warnf("Failed to forward %d log(s), as the sinks can't keep up with the tail", len(logs))
+	}
+}
+
+// forwardAlert queues the alert for the sinks of the rule that raised it,
+// rather than for the sinks of the forwarder. The alert is dropped with a
+// warning if the sinks fell too far behind.
+func (f *logTailForwarder) forwardAlert(alert *display.LogAlert, sinks []logTailSink) {
+	if len(sinks) == 0 {
+		return
+	}
+
+	if !f.enqueue(func() {
+		for _, sink := range sinks {
+			if err := sink.Send(f.ctx, alert); err != nil {
+				f.cli.renderer.Warnf("Failed to forward alert %s: %v", alert.Rule, err)
+			}
+		}
+	}) {
+		f.cli.renderer.Warnf("Failed to forward alert %s, as the sinks can't keep up with the logs", alert.Rule)
+	}
+}
+
+func (f *logTailForwarder) enqueue(send func()) bool {
+	select {
+	case f.queue <- send:
+		return true
+	default:
+		return false
+	}
+}
+
+// close waits for the queued logs and alerts to be sent to the sinks.
+func (f *logTailForwarder) close() {
+	close(f.queue)
+	<-f.done
+}
+
+func newNDJSONLogTailSink(w io.Writer) *ndjsonLogTailSink {
+	return &ndjsonLogTailSink{encoder: json.NewEncoder(w)}
+}
+
+func (s *ndjsonLogTailSink) Send(_ context.Context, event interface{}) error {
+	return s.encoder.Encode(event)
+}
+
+func (s *execLogTailSink) Send(ctx context.Context, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+
+	cmd := exec.CommandContext(ctx, shell, flag, s.command)
+	cmd.Stdin = bytes.NewReader(payload)
+	// The output of the command is kept apart from the tailed logs, which may be piped.
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run %q: %w", s.command, err)
+	}
+
+	return nil
+}
+
+func newWebhookLogTailSink(url string) *webhookLogTailSink {
+	return &webhookLogTailSink{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *webhookLogTailSink) Send(ctx context.Context, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %s", response.Status)
+	}
+
+	return nil
+}
+
+// sleepContext waits for the given duration, unless the context is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
@@ -0,0 +1,223 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestLogTailFilter(t *testing.T) {
+	logs := []*management.Log{
+		{LogID: auth0.String("1"), Type: auth0.String("s")},
+		{LogID: auth0.String("2"), Type: auth0.String("f")},
+		{LogID: auth0.String("3"), Type: auth0.String("fp")},
+		{LogID: auth0.String("4"), Type: auth0.String("w")},
+	}
+
+	var testCases = []struct {
+		name     string
+		filter   logTailFilter
+		expected []string
+	}{
+		{name: "no filter", filter: logTailFilter{}, expected: []string{"1", "2", "3", "4"}},
+		{name: "by type", filter: logTailFilter{types: []string{"s", "fp"}}, expected: []string{"1", "3"}},
+		{name: "by category", filter: logTailFilter{categories: []string{"failure"}}, expected: []string{"2", "3"}},
+		{
+			name:     "by type and category",
+			filter:   logTailFilter{types: []string{"f", "w"}, categories: []string{"failure"}},
+			expected: []string{"2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var ids []string
+			for _, log := range testCase.filter.apply(logs) {
+				ids = append(ids, log.GetLogID())
+			}
+			assert.Equal(t, testCase.expected, ids)
+		})
+	}
+}
+
+func TestWebhookLogTailSink(t *testing.T) {
+	t.Run("it posts the log as json", func(t *testing.T) {
+		var received management.Log
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		err := newWebhookLogTailSink(server.URL).Send(context.Background(), &management.Log{LogID: auth0.String("log-1")})
+
+		require.NoError(t, err)
+		assert.Equal(t, "log-1", received.GetLogID())
+	})
+
+	t.Run("it returns an error when the webhook fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		err := newWebhookLogTailSink(server.URL).Send(context.Background(), &management.Log{LogID: auth0.String("log-1")})
+
+		assert.EqualError(t, err, "webhook responded with status 500 Internal Server Error")
+	})
+}
+
+// blockingLogTailSink records the logs it receives, once unblocked.
+type blockingLogTailSink struct {
+	started  chan struct{}
+	once     sync.Once
+	unblock  chan struct{}
+	received []string
+}
+
+func (s *blockingLogTailSink) Send(_ context.Context, event interface{}) error {
+	s.once.Do(func() { close(s.started) })
+	<-s.unblock
+	s.received = append(s.received, event.(*management.Log).GetLogID())
+	return nil
+}
+
+func TestLogTailForwarder(t *testing.T) {
+	sink := &blockingLogTailSink{started: make(chan struct{}), unblock: make(chan struct{})}
+	messages := &bytes.Buffer{}
+	cli := &cli{renderer: &display.Renderer{MessageWriter: messages, ResultWriter: io.Discard}}
+
+	forwarder := cli.newLogTailForwarder(context.Background(), []logTailSink{sink})
+
+	forwarder.forward([]*management.Log{{LogID: auth0.String("log-0")}})
+	<-sink.started
+
+	// Forwarding doesn't wait for the sink, up to the size of the buffer.
+	for i := 1; i <= logTailForwarderBuffer+1; i++ {
+		forwarder.forward([]*management.Log{{LogID: auth0.String(fmt.Sprintf("log-%d", i))}})
+	}
+	assert.Contains(t, messages.String(), "Failed to forward 1 log(s), as the sinks can't keep up with the tail")
+
+	close(sink.unblock)
+	forwarder.close()
+
+	// The batch being sent when the buffer filled up, and the buffered ones, are all sent.
+	assert.Len(t, sink.received, logTailForwarderBuffer+1)
+	assert.Equal(t, "log-0", sink.received[0])
+}
+
+func TestLogTailForwarderForwardAlert(t *testing.T) {
+	sink := &blockingLogTailSink{started: make(chan struct{}), unblock: make(chan struct{})}
+	cli := &cli{renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard}}
+
+	var alerts []interface{}
+	alertSink := logTailSinkFunc(func(_ context.Context, event interface{}) error {
+		alerts = append(alerts, event)
+		return nil
+	})
+
+	// The alerts go to the sinks of their rule, not to the ones of the forwarder.
+	forwarder := cli.newLogTailForwarder(context.Background(), []logTailSink{sink})
+	forwarder.forwardAlert(&display.LogAlert{Rule: "brute-force", Count: 21}, []logTailSink{alertSink})
+	forwarder.close()
+
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "brute-force", alerts[0].(*display.LogAlert).Rule)
+	assert.Empty(t, sink.received)
+}
+
+type logTailSinkFunc func(ctx context.Context, event interface{}) error
+
+func (f logTailSinkFunc) Send(ctx context.Context, event interface{}) error {
+	return f(ctx, event)
+}
+
+func TestExecLogTailSink(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command relies on a POSIX shell")
+	}
+
+	output := filepath.Join(t.TempDir(), "log.json")
+	sink := &execLogTailSink{command: fmt.Sprintf("cat > %q", output)}
+
+	err := sink.Send(context.Background(), &management.Log{LogID: auth0.String("log-1")})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(output)
+	require.NoError(t, err)
+
+	var received management.Log
+	require.NoError(t, json.Unmarshal(content, &received))
+	assert.Equal(t, "log-1", received.GetLogID())
+}
+
+func TestTailLogsCommandWithNDJSONOutput(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logAPI := mock.NewMockLogAPI(ctrl)
+	logAPI.EXPECT().
+		List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]*management.Log{
+			{ID: auth0.String("2"), LogID: auth0.String("log-2"), Type: auth0.String("f")},
+			{ID: auth0.String("1"), LogID: auth0.String("log-1"), Type: auth0.String("s")},
+		}, nil)
+	logAPI.EXPECT().
+		List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("generic error"))
+
+	result := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: result},
+		api:      &auth0.API{Log: logAPI},
+	}
+
+	cmd := tailLogsCmd(cli)
+	cmd.SetArgs([]string{"--number", "2", "--category", "failure", "--output", "ndjson"})
+	err := cmd.Execute()
+
+	require.NoError(t, err)
+
+	var received management.Log
+	decoder := json.NewDecoder(result)
+	require.NoError(t, decoder.Decode(&received))
+	assert.Equal(t, "log-2", received.GetLogID())
+	assert.False(t, decoder.More())
+}
+
+func TestTailLogsCommandValidation(t *testing.T) {
+	var testCases = []struct {
+		args     []string
+		expected string
+	}{
+		{args: []string{"--output", "csv"}, expected: `invalid output "csv", please use 'table' or 'ndjson'`},
+		{args: []string{"--category", "errors"}, expected: `invalid category "errors", please use one of: success, warning, failure, unknown`},
+		{args: []string{"--interval", "0s"}, expected: "the interval must be positive"},
+	}
+
+	for _, testCase := range testCases {
+		cmd := tailLogsCmd(&cli{})
+		cmd.SetArgs(testCase.args)
+		assert.EqualError(t, cmd.Execute(), testCase.expected)
+	}
+}
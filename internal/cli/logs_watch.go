@@ -0,0 +1,313 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+var logsWatchRules = Flag{
+	Name:       "Rules",
+	LongForm:   "rules",
+	ShortForm:  "r",
+	Help:       "YAML file of the alerting rules to evaluate over the tailed logs.",
+	IsRequired: true,
+}
+
+// logWatchRules is the content of the rules file.
+type logWatchRules struct {
+	Rules []*logWatchRule `yaml:"rules"`
+}
+
+// logWatchRule raises an alert when more than Threshold logs matching it, and
+// sharing the same GroupBy values, are dated within a sliding Window.
+type logWatchRule struct {
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description"`
+	Types       []string          `yaml:"types"`
+	Categories  []string          `yaml:"categories"`
+	Match       map[string]string `yaml:"match"`
+	GroupBy     []string          `yaml:"group_by"`
+	Threshold   int               `yaml:"threshold"`
+	Window      time.Duration     `yaml:"window"`
+	Exec        string            `yaml:"exec"`
+	Webhook     string            `yaml:"webhook"`
+
+	filter   logTailFilter
+	matchers map[string]*regexp.Regexp
+	sinks    []logTailSink
+	// windows holds the logs of the current window of each group, sorted by date.
+	windows map[string][]*management.Log
+	// sweptAt is the date of the log that the windows were last swept at.
+	sweptAt time.Time
+}
+
+func watchLogsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Rules    string
+		Filter   string
+		Interval time.Duration
+	}
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Args:  cobra.NoArgs,
+		Short: "Watch the tenant logs and raise alerts",
+		Long: "Tail the tenant logs and evaluate threshold rules over sliding windows, such as more than 20 failed " +
+			"logins from the same IP address in 5 minutes.\n\n" +
+			"Rules are read from a YAML file. Each rule selects logs by type, category or by matching fields against " +
+			"regular expressions, and raises an alert when more than `threshold` of them, sharing the same `group_by` " +
+			"values, are dated within `window`. Alerts are printed, and can also run a command or be posted to a " +
+			"webhook, which both receive the alert as JSON:\n\n" +
+			"```yaml\n" +
+			"rules:\n" +
+			"  - name: brute-force\n" +
+			"    description: More than 20 failed logins from one IP in 5 minutes\n" +
+			"    types: [f, fp, fu]\n" +
+			"    group_by: [ip]\n" +
+			"    threshold: 20\n" +
+			"    window: 5m\n" +
+			"    exec: notify-send \"Auth0 alert\"\n" +
+			"  - name: client-deleted\n" +
+			"    types: [sapi]\n" +
+			"    match:\n" +
+			"      description: (?i)delete.*client\n" +
+			"    webhook: http://localhost:9000/alerts\n" +
+			"```",
+		Example: `  auth0 logs watch --rules rules.yaml
+  auth0 logs watch -r rules.yaml --filter "client_id:<client-id>"
+  auth0 logs watch -r rules.yaml --interval 10s
+  auth0 logs watch -r rules.yaml --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rules, err := readLogWatchRules(inputs.Rules)
+			if err != nil {
+				return err
+			}
+
+			pollOptions := logPollOptions{Filter: inputs.Filter, Interval: inputs.Interval}
+			if err := pollOptions.validate(); err != nil {
+				return err
+			}
+
+			// Only the logs following the latest one are watched.
+			list, err := getLatestLogs(cmd.Context(), cli, 1, inputs.Filter)
+			if err != nil {
+				return fmt.Errorf("failed to list logs: %w", err)
+			}
+
+			var lastLogID string
+			if len(list) > 0 {
+				lastLogID = list[0].GetLogID()
+			}
+
+			cli.renderer.Infof("Watching the logs with %d rule(s), press %s to stop.", len(rules), ansi.Bold("Ctrl+C"))
+
+			// Create a `set` to detect duplicates clientside.
+			set := make(map[string]struct{})
+			dedupeLogs(list, set)
+
+			forwarder := cli.newLogTailForwarder(cmd.Context(), nil)
+			defer forwarder.close()
+
+			cli.pollLogs(cmd.Context(), lastLogID, set, pollOptions, func(logs []*management.Log) {
+				for _, log := range logs {
+					for _, rule := range rules {
+						alert := rule.evaluate(log)
+						if alert == nil {
+							continue
+						}
+
+						cli.renderer.LogAlert(*alert)
+						forwarder.forwardAlert(alert, rule.sinks)
+					}
+				}
+			})
+
+			return nil
+		},
+	}
+
+	logsWatchRules.RegisterString(cmd, &inputs.Rules, "")
+	logsFilter.RegisterString(cmd, &inputs.Filter, "")
+	logsTailInterval.RegisterDuration(cmd, &inputs.Interval, 2*time.Second)
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func readLogWatchRules(path string) ([]*logWatchRule, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules file %q: %w", path, err)
+	}
+
+	var file logWatchRules
+	if err := yaml.UnmarshalStrict(content, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse rules file %q: %w", path, err)
+	}
+
+	if len(file.Rules) == 0 {
+		return nil, fmt.Errorf("no rules found in %q", path)
+	}
+
+	for _, rule := range file.Rules {
+		if err := rule.init(); err != nil {
+			return nil, err
+		}
+	}
+
+	return file.Rules, nil
+}
+
+// init validates the rule and prepares it for evaluation.
+func (r *logWatchRule) init() error {
+	if r.Name == "" {
+		return errors.New("every rule must have a name")
+	}
+
+	if r.Threshold < 0 {
+		return fmt.Errorf("invalid threshold for rule %q, it must be positive", r.Name)
+	}
+
+	if r.Threshold > 0 && r.Window <= 0 {
+		return fmt.Errorf("invalid window for rule %q, it's required when the threshold is above 0", r.Name)
+	}
+
+	for _, category := range r.Categories {
+		if !slices.Contains(display.LogCategories, category) {
+			return fmt.Errorf(
+				"invalid category %q for rule %q, please use one of: %s",
+				category, r.Name, strings.Join(display.LogCategories, ", "),
+			)
+		}
+	}
+
+	for _, dimension := range r.GroupBy {
+		if !slices.Contains(display.LogDimensions, dimension) {
+			return fmt.Errorf(
+				"invalid dimension %q for rule %q, please use one of: %s",
+				dimension, r.Name, strings.Join(display.LogDimensions, ", "),
+			)
+		}
+	}
+
+	r.matchers = make(map[string]*regexp.Regexp, len(r.Match))
+	for dimension, pattern := range r.Match {
+		if !slices.Contains(display.LogDimensions, dimension) {
+			return fmt.Errorf(
+				"invalid dimension %q for rule %q, please use one of: %s",
+				dimension, r.Name, strings.Join(display.LogDimensions, ", "),
+			)
+		}
+
+		matcher, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern for %q in rule %q: %w", dimension, r.Name, err)
+		}
+		r.matchers[dimension] = matcher
+	}
+
+	r.filter = logTailFilter{types: r.Types, categories: r.Categories}
+
+	if r.Exec != "" {
+		r.sinks = append(r.sinks, &execLogTailSink{command: r.Exec})
+	}
+	if r.Webhook != "" {
+		r.sinks = append(r.sinks, newWebhookLogTailSink(r.Webhook))
+	}
+
+	r.windows = make(map[string][]*management.Log)
+
+	return nil
+}
+
+// evaluate adds the log to the window of its group if it matches the rule, and
+// returns an alert if the threshold is exceeded. The window of the group is then
+// emptied, so that the same logs don't raise an alert twice.
+func (r *logWatchRule) evaluate(log *management.Log) *display.LogAlert {
+	if log.GetDate().Sub(r.sweptAt) >= r.Window {
+		r.sweep(log.GetDate())
+	}
+
+	if !r.filter.match(log) {
+		return nil
+	}
+
+	for dimension, matcher := range r.matchers {
+		if !matcher.MatchString(display.LogDimension(log, dimension)) {
+			return nil
+		}
+	}
+
+	group := make(map[string]string, len(r.GroupBy))
+	values := make([]string, len(r.GroupBy))
+	for i, dimension := range r.GroupBy {
+		values[i] = display.LogDimension(log, dimension)
+		group[dimension] = values[i]
+	}
+	key := strings.Join(values, "\x00")
+
+	// Drop the logs that slid out of the window.
+	r.windows[key] = append(r.windows[key], log)
+	window := r.windows[key]
+	start := log.GetDate().Add(-r.Window)
+	for len(window) > 0 && window[0].GetDate().Before(start) {
+		window = window[1:]
+	}
+
+	if len(window) <= r.Threshold {
+		r.windows[key] = window
+		return nil
+	}
+	delete(r.windows, key)
+
+	logIDs := make([]string, 0, len(window))
+	for _, l := range window {
+		logIDs = append(logIDs, l.GetLogID())
+	}
+
+	return &display.LogAlert{
+		Rule:        r.Name,
+		Description: r.Description,
+		Group:       group,
+		Count:       len(window),
+		Window:      r.Window.String(),
+		From:        window[0].GetDate(),
+		To:          log.GetDate(),
+		LogIDs:      logIDs,
+	}
+}
+
+// sweep drops the logs that slid out of the windows as of the given date, along
+// with the groups left without logs, so that groups that stopped logging, such
+// as the IPs of a past attack, don't pile up for as long as the logs are watched.
+func (r *logWatchRule) sweep(date time.Time) {
+	start := date.Add(-r.Window)
+	for key, window := range r.windows {
+		for len(window) > 0 && window[0].GetDate().Before(start) {
+			window = window[1:]
+		}
+
+		if len(window) == 0 {
+			delete(r.windows, key)
+			continue
+		}
+		r.windows[key] = window
+	}
+
+	r.sweptAt = date
+}
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+func writeLogWatchRules(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func TestReadLogWatchRules(t *testing.T) {
+	t.Run("it reads the rules", func(t *testing.T) {
+		path := writeLogWatchRules(t, `
+rules:
+  - name: brute-force
+    types: [f, fp]
+    group_by: [ip]
+    threshold: 20
+    window: 5m
+  - name: client-deleted
+    types: [sapi]
+    match:
+      description: (?i)delete.*client
+`)
+
+		rules, err := readLogWatchRules(path)
+
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+		assert.Equal(t, 5*time.Minute, rules[0].Window)
+		assert.Equal(t, 20, rules[0].Threshold)
+		assert.Contains(t, rules[1].matchers, "description")
+	})
+
+	var testCases = []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "no rules",
+			content:  "rules: []",
+			expected: "no rules found in",
+		},
+		{
+			name:     "missing window",
+			content:  "rules:\n  - name: spike\n    threshold: 10",
+			expected: `invalid window for rule "spike", it's required when the threshold is above 0`,
+		},
+		{
+			name:     "unknown dimension",
+			content:  "rules:\n  - name: spike\n    group_by: [country]",
+			expected: `invalid dimension "country" for rule "spike"`,
+		},
+		{
+			name:     "invalid pattern",
+			content:  "rules:\n  - name: spike\n    match:\n      ip: \"[\"",
+			expected: `invalid pattern for "ip" in rule "spike"`,
+		},
+		{
+			name:     "unknown field",
+			content:  "rules:\n  - name: spike\n    treshold: 10",
+			expected: "failed to parse rules file",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run("it returns an error for "+testCase.name, func(t *testing.T) {
+			_, err := readLogWatchRules(writeLogWatchRules(t, testCase.content))
+			assert.ErrorContains(t, err, testCase.expected)
+		})
+	}
+}
+
+func TestLogWatchRuleEvaluate(t *testing.T) {
+	start := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	failedLogin := func(id, ip string, at time.Duration) *management.Log {
+		return &management.Log{
+			LogID: auth0.String(id),
+			Type:  auth0.String("f"),
+			IP:    auth0.String(ip),
+			Date:  auth0.Time(start.Add(at)),
+		}
+	}
+
+	t.Run("it raises an alert when the threshold is exceeded within the window", func(t *testing.T) {
+		rule := &logWatchRule{Name: "brute-force", Types: []string{"f"}, GroupBy: []string{"ip"}, Threshold: 2, Window: 5 * time.Minute}
+		require.NoError(t, rule.init())
+
+		assert.Nil(t, rule.evaluate(failedLogin("1", "10.0.0.1", 0)))
+		assert.Nil(t, rule.evaluate(failedLogin("2", "10.0.0.2", time.Minute)))
+		assert.Nil(t, rule.evaluate(failedLogin("3", "10.0.0.1", 2*time.Minute)))
+		assert.Nil(t, rule.evaluate(&management.Log{LogID: auth0.String("4"), Type: auth0.String("s"), IP: auth0.String("10.0.0.1")}))
+
+		alert := rule.evaluate(failedLogin("5", "10.0.0.1", 4*time.Minute))
+		require.NotNil(t, alert)
+		assert.Equal(t, "brute-force", alert.Rule)
+		assert.Equal(t, map[string]string{"ip": "10.0.0.1"}, alert.Group)
+		assert.Equal(t, 3, alert.Count)
+		assert.Equal(t, []string{"1", "3", "5"}, alert.LogIDs)
+		assert.Equal(t, start, alert.From)
+
+		// The window is emptied once the alert is raised.
+		assert.Nil(t, rule.evaluate(failedLogin("6", "10.0.0.1", 4*time.Minute)))
+	})
+
+	t.Run("it drops the logs that slid out of the window", func(t *testing.T) {
+		rule := &logWatchRule{Name: "brute-force", GroupBy: []string{"ip"}, Threshold: 1, Window: time.Minute}
+		require.NoError(t, rule.init())
+
+		assert.Nil(t, rule.evaluate(failedLogin("1", "10.0.0.1", 0)))
+		assert.Nil(t, rule.evaluate(failedLogin("2", "10.0.0.1", 2*time.Minute)))
+		assert.NotNil(t, rule.evaluate(failedLogin("3", "10.0.0.1", 150*time.Second)))
+	})
+
+	t.Run("it forgets the groups that stopped logging", func(t *testing.T) {
+		rule := &logWatchRule{Name: "brute-force", GroupBy: []string{"ip"}, Threshold: 5, Window: time.Minute}
+		require.NoError(t, rule.init())
+
+		assert.Nil(t, rule.evaluate(failedLogin("1", "10.0.0.1", 0)))
+		assert.Nil(t, rule.evaluate(failedLogin("2", "10.0.0.2", 30*time.Second)))
+		assert.Len(t, rule.windows, 2)
+
+		assert.Nil(t, rule.evaluate(failedLogin("3", "10.0.0.3", 80*time.Second)))
+		assert.Len(t, rule.windows, 2, "the group of 10.0.0.1 has no logs left in the window")
+
+		assert.Nil(t, rule.evaluate(failedLogin("4", "10.0.0.3", 3*time.Minute)))
+		assert.Len(t, rule.windows, 1)
+		assert.Contains(t, rule.windows, "10.0.0.3")
+	})
+
+	t.Run("it raises an alert on any matching log without threshold", func(t *testing.T) {
+		rule := &logWatchRule{Name: "client-deleted", Types: []string{"sapi"}, Match: map[string]string{"description": "(?i)delete.*client"}}
+		require.NoError(t, rule.init())
+
+		assert.Nil(t, rule.evaluate(&management.Log{Type: auth0.String("sapi"), Description: auth0.String("Update a client")}))
+		assert.NotNil(t, rule.evaluate(&management.Log{Type: auth0.String("sapi"), Description: auth0.String("Delete a client")}))
+	})
+}
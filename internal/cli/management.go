@@ -1,14 +1,19 @@
 package cli
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand/v2"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/PuerkitoBio/rehttp"
@@ -17,22 +22,64 @@ import (
 	"github.com/auth0/go-auth0/v3/management/option"
 
 	"github.com/auth0/auth0-cli/internal/buildinfo"
+	"github.com/auth0/auth0-cli/internal/har"
 )
 
-func initializeManagementClient(tenantDomain string, accessToken string, invokerMetadata string) (*management.Management, error) {
+const (
+	// defaultMaxRetries is how many times a failed Management API request is retried by default.
+	defaultMaxRetries = 3
+
+	// maxConcurrentRequests caps the number of requests in flight to a tenant at once.
+	maxConcurrentRequests = 10
+
+	// rateLimitBaseDelay and rateLimitMaxBackoff bound the backoff between retries of a rate limited request.
+	rateLimitBaseDelay  = 500 * time.Millisecond
+	rateLimitMaxBackoff = 10 * time.Second
+
+	// rateLimitMaxDelay caps how long a rate limited request waits for the rate limit
+	// to reset. Beyond it, such as for a daily quota, the request is not retried.
+	rateLimitMaxDelay = time.Minute
+)
+
+// retryPolicy configures how the Management API clients retry failed requests.
+type retryPolicy struct {
+	maxRetries int
+
+	// Logf, when set, is called on each retry.
+	logf func(format string, args ...interface{})
+}
+
+func (p retryPolicy) log(format string, args ...interface{}) {
+	if p.logf != nil {
+		p.logf(format, args...)
+	}
+}
+
+// retryPolicy returns the retry policy set through the --max-retries
+// flag, which logs each retry in debug mode.
+func (c *cli) retryPolicy() retryPolicy {
+	policy := retryPolicy{maxRetries: c.maxRetries}
+	if c.debug {
+		policy.logf = c.renderer.Infof
+	}
+
+	return policy
+}
+
+func initializeManagementClient(tenantDomain string, accessToken string, invokerMetadata string, retries retryPolicy, transport http.RoundTripper) (*management.Management, error) {
 	client, err := management.New(
 		tenantDomain,
 		management.WithStaticToken(accessToken),
 		management.WithUserAgent(fmt.Sprintf("%v/%v", userAgent, strings.TrimPrefix(buildinfo.Version, "v"))),
 		management.WithAuth0ClientEnvEntry("Auth0-CLI", strings.TrimPrefix(buildinfo.Version, "v")),
 		management.WithNoRetries(),
-		management.WithClient(customClientWithRetries(invokerMetadata)),
+		management.WithClient(customClientWithRetries(invokerMetadata, retries, transport)),
 	)
 
 	return client, err
 }
 
-func initializeManagementClientV3(tenantDomain string, accessToken string, invokerMetadata string) (*managementv3.Management, error) {
+func initializeManagementClientV3(tenantDomain string, accessToken string, invokerMetadata string, retries retryPolicy, transport http.RoundTripper) (*managementv3.Management, error) {
 	client, err := managementv3.New(
 		tenantDomain,
 		option.WithToken(accessToken),
@@ -42,52 +89,199 @@ func initializeManagementClientV3(tenantDomain string, accessToken string, invok
 		// Setting it to 1 to avoid retries from `go-auth0` since we have our own retry logic in the custom HTTP client.
 		// TODO: confirm this assumption, or check if this needs to be excluded like terraform provider.
 		option.WithMaxAttempts(1),
-		option.WithHTTPClient(customClientWithRetries(invokerMetadata)),
+		option.WithHTTPClient(customClientWithRetries(invokerMetadata, retries, transport)),
 	)
 	return client, err
 }
 
-func customClientWithRetries(invokerMetadata string) *http.Client {
+// customClientWithRetries returns the HTTP client of the Management API clients,
+// which sends the requests through the given transport.
+func customClientWithRetries(invokerMetadata string, retries retryPolicy, transport http.RoundTripper) *http.Client {
 	client := &http.Client{
 		// The metadata transport wraps the retry transports so the header is stamped
 		// once, before any retry decision, and is preserved across retries.
 		Transport: invokerMetadataTransport{
 			metadata: invokerMetadata,
-			base: rateLimitTransport(
-				retryableErrorTransport(
-					http.DefaultTransport,
+			base: rateLimitTransport{
+				policy: retries,
+				base: retryableErrorTransport(
+					transport,
+					retries,
 				),
-			),
+			},
 		},
 	}
 
 	return client
 }
 
-func rateLimitTransport(tripper http.RoundTripper) http.RoundTripper {
-	return rehttp.NewTransport(tripper, rateLimitRetry, rateLimitDelay)
+// rateLimiter throttles the requests sent to a tenant. It caps how many are in flight
+// at once and, once the rate limit is exhausted, holds them all until it resets.
+type rateLimiter struct {
+	slots chan struct{}
+
+	mu          sync.Mutex
+	pausedUntil time.Time
 }
 
-func rateLimitRetry(attempt rehttp.Attempt) bool {
-	if attempt.Response == nil {
-		return false
+// rateLimiters holds a rateLimiter per tenant domain, shared by all the Management API clients.
+var rateLimiters sync.Map
+
+func rateLimiterFor(host string) *rateLimiter {
+	limiter, ok := rateLimiters.Load(host)
+	if !ok {
+		limiter, _ = rateLimiters.LoadOrStore(host, &rateLimiter{slots: make(chan struct{}, maxConcurrentRequests)})
 	}
 
-	return attempt.Response.StatusCode == http.StatusTooManyRequests
+	return limiter.(*rateLimiter)
 }
 
-func rateLimitDelay(attempt rehttp.Attempt) time.Duration {
-	resetAt := attempt.Response.Header.Get("X-RateLimit-Reset")
+// acquire waits for a free slot, then for the rate limit to reset if it is exhausted.
+func (l *rateLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	wait := time.Until(l.pausedUntil)
+	l.mu.Unlock()
 
-	resetAtUnix, err := strconv.ParseInt(resetAt, 10, 64)
+	if err := sleepWithContext(ctx, wait); err != nil {
+		l.release()
+		return err
+	}
+
+	return nil
+}
+
+func (l *rateLimiter) release() {
+	<-l.slots
+}
+
+// pause holds the requests to come until the given time.
+func (l *rateLimiter) pause(until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// rateLimitTransport throttles the requests to stay within the rate limit of the Management
+// API, as told by the X-RateLimit-* headers, and retries the ones that still exceeded it.
+type rateLimitTransport struct {
+	base   http.RoundTripper
+	policy retryPolicy
+}
+
+func (t rateLimitTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	limiter := rateLimiterFor(request.URL.Host)
+
+	for retry := 0; ; retry++ {
+		if retry > 0 && request.Body != nil && request.Body != http.NoBody {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			request = request.Clone(request.Context())
+			request.Body = body
+		}
+
+		if err := limiter.acquire(request.Context()); err != nil {
+			return nil, err
+		}
+
+		response, err := t.base.RoundTrip(request)
+		limiter.release()
+		if err != nil {
+			return nil, err
+		}
+
+		reset, hasReset := rateLimitReset(response.Header)
+		if hasReset && response.Header.Get("X-RateLimit-Remaining") == "0" && time.Until(reset) <= rateLimitMaxDelay {
+			limiter.pause(reset)
+		}
+
+		if response.StatusCode != http.StatusTooManyRequests || retry >= t.policy.maxRetries {
+			return response, nil
+		}
+
+		if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+			return response, nil
+		}
+
+		delay := rateLimitDelay(response.Header, retry)
+		if delay > rateLimitMaxDelay {
+			t.policy.log(
+				"Rate limit of %s %s resets in %s, which is too long to retry.",
+				request.Method, request.URL.Path, delay.Round(time.Second),
+			)
+			return response, nil
+		}
+
+		t.policy.log(
+			"Rate limit of %s %s exceeded, retrying in %s (retry %d of %d).",
+			request.Method, request.URL.Path, delay.Round(time.Millisecond), retry+1, t.policy.maxRetries,
+		)
+
+		_, _ = io.Copy(io.Discard, response.Body)
+		_ = response.Body.Close()
+
+		if err := sleepWithContext(request.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rateLimitReset returns when the rate limit resets, as told by the X-RateLimit-Reset header.
+func rateLimitReset(header http.Header) (time.Time, bool) {
+	resetAt, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
 	if err != nil {
-		resetAtUnix = time.Now().Add(5 * time.Second).Unix()
+		return time.Time{}, false
+	}
+
+	return time.Unix(resetAt, 0), true
+}
+
+// rateLimitDelay returns how long to wait before retrying a rate limited request: until the rate
+// limit resets, if known, plus an exponential backoff with jitter so that the requests held by
+// the same rate limit don't all retry at once.
+func rateLimitDelay(header http.Header, retry int) time.Duration {
+	backoff := rateLimitBaseDelay << min(retry, 5)
+	if backoff > rateLimitMaxBackoff {
+		backoff = rateLimitMaxBackoff
 	}
 
-	return time.Duration(resetAtUnix-time.Now().Unix()) * time.Second
+	delay := backoff/2 + rand.N(backoff/2)
+
+	if reset, ok := rateLimitReset(header); ok && time.Until(reset) > 0 {
+		delay += time.Until(reset)
+	}
+
+	return delay
+}
+
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
-func retryableErrorTransport(tripper http.RoundTripper) http.RoundTripper {
+func retryableErrorTransport(tripper http.RoundTripper, retries retryPolicy) http.RoundTripper {
 	retryableCodes := []int{
 		http.StatusServiceUnavailable,
 		http.StatusInternalServerError,
@@ -99,15 +293,32 @@ func retryableErrorTransport(tripper http.RoundTripper) http.RoundTripper {
 		524,
 	}
 
+	retry := rehttp.RetryAll(
+		rehttp.RetryMaxRetries(retries.maxRetries),
+		rehttp.RetryAny(
+			rehttp.RetryStatuses(retryableCodes...),
+			rehttp.RetryIsErr(retryableErrorRetryFunc),
+		),
+	)
+
 	return rehttp.NewTransport(
 		tripper,
-		rehttp.RetryAll(
-			rehttp.RetryMaxRetries(3),
-			rehttp.RetryAny(
-				rehttp.RetryStatuses(retryableCodes...),
-				rehttp.RetryIsErr(retryableErrorRetryFunc),
-			),
-		),
+		func(attempt rehttp.Attempt) bool {
+			if !retry(attempt) {
+				return false
+			}
+
+			reason := "an error"
+			if attempt.Response != nil {
+				reason = attempt.Response.Status
+			}
+			retries.log(
+				"Request %s %s failed with %s, retrying (retry %d of %d).",
+				attempt.Request.Method, attempt.Request.URL.Path, reason, attempt.Index+1, retries.maxRetries,
+			)
+
+			return true
+		},
 		rehttp.ExpJitterDelay(500*time.Millisecond, 10*time.Second),
 	)
 }
@@ -117,6 +328,11 @@ func retryableErrorRetryFunc(err error) bool {
 		return false
 	}
 
+	// Don't retry if the request is missing from the recording being replayed.
+	if errors.Is(err, har.ErrNotRecorded) {
+		return false
+	}
+
 	if v, ok := err.(*url.Error); ok {
 		// Don't retry if the error was due to too many redirects.
 		if regexp.MustCompile(`stopped after \d+ redirects\z`).MatchString(v.Error()) {
@@ -1,18 +1,26 @@
 package cli
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/har"
 )
 
 func TestCustomClientWithRetries(t *testing.T) {
@@ -33,7 +41,7 @@ func TestCustomClientWithRetries(t *testing.T) {
 			writer.WriteHeader(200)
 		}))
 
-		client := customClientWithRetries("")
+		client := customClientWithRetries("", retryPolicy{maxRetries: defaultMaxRetries}, http.DefaultTransport)
 
 		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
 		require.NoError(t, err)
@@ -52,6 +60,100 @@ func TestCustomClientWithRetries(t *testing.T) {
 		})
 	})
 
+	t.Run("it does not retry more than the max retries on rate limit error", func(t *testing.T) {
+		apiCalls := 0
+		testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			apiCalls++
+			writer.WriteHeader(429)
+		}))
+
+		var logs []string
+		client := customClientWithRetries("", retryPolicy{
+			maxRetries: 1,
+			logf: func(format string, args ...interface{}) {
+				logs = append(logs, fmt.Sprintf(format, args...))
+			},
+		}, http.DefaultTransport)
+
+		request, err := http.NewRequest(http.MethodGet, testServer.URL+"/api/v2/users", nil)
+		require.NoError(t, err)
+
+		response, err := client.Do(request)
+		require.NoError(t, err)
+
+		assert.Equal(t, 429, response.StatusCode)
+		assert.Equal(t, 1+1, apiCalls) // 1 retry + 1 first call.
+		require.Len(t, logs, 1)
+		assert.Contains(t, logs[0], "Rate limit of GET /api/v2/users exceeded, retrying in")
+		assert.Contains(t, logs[0], "(retry 1 of 1)")
+
+		t.Cleanup(func() {
+			testServer.Close()
+			err := response.Body.Close()
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("it resends the body of a rate limited request", func(t *testing.T) {
+		var bodies []string
+		testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, err := io.ReadAll(request.Body)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+
+			if len(bodies) == 1 {
+				writer.WriteHeader(429)
+				return
+			}
+
+			writer.WriteHeader(201)
+		}))
+
+		client := customClientWithRetries("", retryPolicy{maxRetries: defaultMaxRetries}, http.DefaultTransport)
+
+		request, err := http.NewRequest(http.MethodPost, testServer.URL, strings.NewReader(`{"name":"test"}`))
+		require.NoError(t, err)
+
+		response, err := client.Do(request)
+		require.NoError(t, err)
+
+		assert.Equal(t, 201, response.StatusCode)
+		assert.Equal(t, []string{`{"name":"test"}`, `{"name":"test"}`}, bodies)
+
+		t.Cleanup(func() {
+			testServer.Close()
+			err := response.Body.Close()
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("it does not retry when the rate limit resets too late", func(t *testing.T) {
+		apiCalls := 0
+		testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			apiCalls++
+			resetAt := time.Now().Add(time.Hour).Unix()
+			writer.Header().Set("X-RateLimit-Reset", strconv.Itoa(int(resetAt)))
+			writer.WriteHeader(429)
+		}))
+
+		client := customClientWithRetries("", retryPolicy{maxRetries: defaultMaxRetries}, http.DefaultTransport)
+
+		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+
+		response, err := client.Do(request)
+		require.NoError(t, err)
+
+		assert.Equal(t, 429, response.StatusCode)
+		assert.Equal(t, 1, apiCalls)
+
+		t.Cleanup(func() {
+			testServer.Close()
+			err := response.Body.Close()
+			require.NoError(t, err)
+		})
+	})
+
 	t.Run("it retries on server error", func(t *testing.T) {
 		apiCalls := 0
 		fail := true
@@ -67,7 +169,7 @@ func TestCustomClientWithRetries(t *testing.T) {
 			writer.WriteHeader(200)
 		}))
 
-		client := customClientWithRetries("")
+		client := customClientWithRetries("", retryPolicy{maxRetries: defaultMaxRetries}, http.DefaultTransport)
 
 		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
 		require.NoError(t, err)
@@ -93,7 +195,7 @@ func TestCustomClientWithRetries(t *testing.T) {
 			writer.WriteHeader(500)
 		}))
 
-		client := customClientWithRetries("")
+		client := customClientWithRetries("", retryPolicy{maxRetries: defaultMaxRetries}, http.DefaultTransport)
 
 		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
 		require.NoError(t, err)
@@ -173,3 +275,29 @@ func TestRetryableErrorRetryFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestSetupWithReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.har")
+	require.NoError(t, har.Write(path, &har.HAR{Log: har.Log{Entries: []har.Entry{
+		{
+			Request: har.Request{Method: http.MethodGet, URL: "https://example.auth0.com/api/v2/tenants/settings"},
+			Response: har.Response{
+				Status:  http.StatusOK,
+				Headers: []har.NameValue{{Name: "Content-Type", Value: "application/json"}},
+				Content: har.Content{Text: `{"friendly_name": "Replayed Tenant"}`},
+			},
+		},
+	}}}))
+
+	cli := &cli{renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard}, replay: path}
+	require.NoError(t, cli.setupWithAuthentication(context.Background()))
+	assert.Equal(t, "example.auth0.com", cli.tenant)
+
+	tenant, err := cli.api.Tenant.Read(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Replayed Tenant", tenant.GetFriendlyName())
+
+	// Requests missing from the recording fail without being retried.
+	_, err = cli.api.Tenant.Read(context.Background())
+	assert.ErrorContains(t, err, "no recorded response for GET example.auth0.com/api/v2/tenants/settings")
+}
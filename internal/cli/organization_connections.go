@@ -0,0 +1,371 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var (
+	organizationConnectionID = Flag{
+		Name:       "Connection ID",
+		LongForm:   "connection-id",
+		ShortForm:  "c",
+		Help:       "ID of the connection.",
+		IsRequired: true,
+	}
+
+	organizationConnectionAssignMembership = Flag{
+		Name:         "Assign Membership On Login",
+		LongForm:     "assign-membership-on-login",
+		ShortForm:    "a",
+		Help:         "Whether users logging in with the connection are automatically granted membership in the organization.",
+		AlwaysPrompt: true,
+	}
+
+	organizationConnectionShowAsButton = Flag{
+		Name:         "Show As Button",
+		LongForm:     "show-as-button",
+		ShortForm:    "b",
+		Help:         "Whether the connection is displayed on the login prompt of the organization. Only applicable for enterprise connections.",
+		AlwaysPrompt: true,
+	}
+)
+
+func connectionsOrganizationCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "connections",
+		Aliases: []string{"conns"},
+		Short:   "Manage connections of an organization",
+		Long: "Manage the connections enabled for an organization. " +
+			"Members of an organization can only log in with the connections enabled for it.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listConnectionsOrganizationCmd(cli))
+	cmd.AddCommand(enableConnectionOrganizationCmd(cli))
+	cmd.AddCommand(updateConnectionOrganizationCmd(cli))
+	cmd.AddCommand(disableConnectionOrganizationCmd(cli))
+
+	return cmd
+}
+
+func listConnectionsOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID  string
+		Number int
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "List connections of an organization",
+		Long: "List the connections enabled for an organization.\n\n" +
+			"To list interactively, use `auth0 orgs connections list` with no arguments.\n\n" +
+			"To list non-interactively, supply the organization id.",
+		Example: `  auth0 orgs connections list
+  auth0 orgs conns ls <org-id>
+  auth0 orgs connections list <org-id> --number 100
+  auth0 orgs conns ls <org-id> --json
+  auth0 orgs conns ls <org-id> --json-compact
+  auth0 orgs conns ls <org-id> --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Number < 1 || inputs.Number > 1000 {
+				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
+			}
+
+			if err := cli.pickOrgID(cmd, args, &inputs.OrgID); err != nil {
+				return err
+			}
+
+			connections, err := cli.getOrgConnections(cmd.Context(), inputs.OrgID, inputs.Number)
+			if err != nil {
+				return err
+			}
+
+			cli.renderer.OrganizationConnectionList(connections)
+			return nil
+		},
+	}
+
+	organizationNumber.Help = "Number of organization connections to retrieve. Minimum 1, maximum 1000."
+	organizationNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	return cmd
+}
+
+func enableConnectionOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID                   string
+		ConnectionID            string
+		AssignMembershipOnLogin bool
+		ShowAsButton            bool
+	}
+
+	cmd := &cobra.Command{
+		Use:     "enable",
+		Aliases: []string{"add"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Enable a connection for an organization",
+		Long: "Enable a connection for an organization, so that its members can log in with it.\n\n" +
+			"To enable interactively, use `auth0 orgs connections enable` with no arguments and answer the prompts.\n\n" +
+			"To enable non-interactively, supply the organization id, the connection id and the settings.",
+		Example: `  auth0 orgs connections enable
+  auth0 orgs connections enable <org-id> --connection-id <connection-id>
+  auth0 orgs connections enable <org-id> --connection-id <connection-id> --assign-membership-on-login
+  auth0 orgs connections enable <org-id> -c <connection-id> -a --show-as-button=false
+  auth0 orgs conns add <org-id> -c <connection-id> -a -b --json
+  auth0 orgs conns add <org-id> -c <connection-id> -a -b --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.pickOrgID(cmd, args, &inputs.OrgID); err != nil {
+				return err
+			}
+
+			if err := organizationConnectionID.Pick(cmd, &inputs.ConnectionID, cli.connectionPickerOptions); err != nil {
+				return err
+			}
+
+			if err := organizationConnectionAssignMembership.AskBool(cmd, &inputs.AssignMembershipOnLogin, nil); err != nil {
+				return err
+			}
+
+			if err := organizationConnectionShowAsButton.AskBool(cmd, &inputs.ShowAsButton, auth0.Bool(true)); err != nil {
+				return err
+			}
+
+			connection := &management.OrganizationConnection{
+				ConnectionID:            auth0.String(inputs.ConnectionID),
+				AssignMembershipOnLogin: auth0.Bool(inputs.AssignMembershipOnLogin),
+				ShowAsButton:            auth0.Bool(inputs.ShowAsButton),
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.AddConnection(cmd.Context(), inputs.OrgID, connection)
+			}); err != nil {
+				return fmt.Errorf("failed to enable connection with ID %q for organization with ID %q: %w", inputs.ConnectionID, inputs.OrgID, err)
+			}
+
+			cli.renderer.OrganizationConnectionEnable(connection)
+			return nil
+		},
+	}
+
+	organizationConnectionID.RegisterString(cmd, &inputs.ConnectionID, "")
+	organizationConnectionAssignMembership.RegisterBool(cmd, &inputs.AssignMembershipOnLogin, false)
+	organizationConnectionShowAsButton.RegisterBool(cmd, &inputs.ShowAsButton, true)
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func updateConnectionOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID                   string
+		ConnectionID            string
+		AssignMembershipOnLogin bool
+		ShowAsButton            bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Update a connection of an organization",
+		Long: "Update the settings of a connection enabled for an organization.\n\n" +
+			"To update interactively, use `auth0 orgs connections update` with no arguments.\n\n" +
+			"To update non-interactively, supply the organization id, the connection id and the settings.",
+		Example: `  auth0 orgs connections update
+  auth0 orgs connections update <org-id> --connection-id <connection-id> --assign-membership-on-login
+  auth0 orgs connections update <org-id> --connection-id <connection-id> --show-as-button=false
+  auth0 orgs conns update <org-id> -c <connection-id> -a=false -b --json
+  auth0 orgs conns update <org-id> -c <connection-id> -a=false -b --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.pickOrgID(cmd, args, &inputs.OrgID); err != nil {
+				return err
+			}
+
+			if err := organizationConnectionID.Pick(cmd, &inputs.ConnectionID, func(ctx context.Context) (pickerOptions, error) {
+				return cli.orgConnectionPickerOptions(ctx, inputs.OrgID)
+			}); err != nil {
+				return err
+			}
+
+			var current *management.OrganizationConnection
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.api.Organization.Connection(cmd.Context(), inputs.OrgID, inputs.ConnectionID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read connection with ID %q of organization with ID %q: %w", inputs.ConnectionID, inputs.OrgID, err)
+			}
+
+			if !organizationConnectionAssignMembership.IsSet(cmd) {
+				inputs.AssignMembershipOnLogin = current.GetAssignMembershipOnLogin()
+			}
+			if err := organizationConnectionAssignMembership.AskBoolU(cmd, &inputs.AssignMembershipOnLogin, current.AssignMembershipOnLogin); err != nil {
+				return err
+			}
+
+			if !organizationConnectionShowAsButton.IsSet(cmd) {
+				inputs.ShowAsButton = current.GetShowAsButton()
+			}
+			if err := organizationConnectionShowAsButton.AskBoolU(cmd, &inputs.ShowAsButton, current.ShowAsButton); err != nil {
+				return err
+			}
+
+			update := &management.OrganizationConnection{
+				AssignMembershipOnLogin: auth0.Bool(inputs.AssignMembershipOnLogin),
+				ShowAsButton:            auth0.Bool(inputs.ShowAsButton),
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.UpdateConnection(cmd.Context(), inputs.OrgID, inputs.ConnectionID, update)
+			}); err != nil {
+				return fmt.Errorf("failed to update connection with ID %q of organization with ID %q: %w", inputs.ConnectionID, inputs.OrgID, err)
+			}
+
+			current.AssignMembershipOnLogin = update.AssignMembershipOnLogin
+			current.ShowAsButton = update.ShowAsButton
+
+			cli.renderer.OrganizationConnectionUpdate(current)
+			return nil
+		},
+	}
+
+	organizationConnectionID.RegisterString(cmd, &inputs.ConnectionID, "")
+	organizationConnectionAssignMembership.RegisterBoolU(cmd, &inputs.AssignMembershipOnLogin, false)
+	organizationConnectionShowAsButton.RegisterBoolU(cmd, &inputs.ShowAsButton, false)
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func disableConnectionOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID         string
+		ConnectionIDs []string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "disable",
+		Aliases: []string{"rm"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Disable connection(s) for an organization",
+		Long: "Disable connection(s) for an organization. Members of the organization can no longer log in with them.\n\n" +
+			"To disable interactively, use `auth0 orgs connections disable` with no arguments.\n\n" +
+			"To disable non-interactively, supply the organization id, connection id(s) and " +
+			"the `--force` flag to skip confirmation.",
+		Example: `  auth0 orgs connections disable
+  auth0 orgs conns rm
+  auth0 orgs connections disable <org-id> --connection-id <connection-id>
+  auth0 orgs connections disable <org-id> --connection-id <conn-id1>,<conn-id2>,<conn-id3>
+  auth0 orgs connections disable <org-id> --connection-id <connection-id> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.pickOrgID(cmd, args, &inputs.OrgID); err != nil {
+				return err
+			}
+
+			if err := organizationConnectionID.PickMany(cmd, &inputs.ConnectionIDs, func(ctx context.Context) (pickerOptions, error) {
+				return cli.orgConnectionPickerOptions(ctx, inputs.OrgID)
+			}); err != nil {
+				return err
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			return ansi.ProgressBar("Disabling connection(s)", inputs.ConnectionIDs, func(_ int, connectionID string) error {
+				if connectionID != "" {
+					if err := cli.api.Organization.DeleteConnection(cmd.Context(), inputs.OrgID, connectionID); err != nil {
+						return fmt.Errorf("failed to disable connection with ID %q for organization %q: %w", connectionID, inputs.OrgID, err)
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	organizationConnectionID.RegisterStringSlice(cmd, &inputs.ConnectionIDs, nil)
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func (c *cli) getOrgConnections(
+	ctx context.Context,
+	orgID string,
+	number int,
+) ([]*management.OrganizationConnection, error) {
+	list, err := getWithPagination(
+		number,
+		func(opts ...management.RequestOption) (result []interface{}, hasNext bool, apiErr error) {
+			connections, apiErr := c.api.Organization.Connections(ctx, url.PathEscape(orgID), opts...)
+			if apiErr != nil {
+				return nil, false, apiErr
+			}
+			var output []interface{}
+			for _, connection := range connections.OrganizationConnections {
+				output = append(output, connection)
+			}
+			return output, connections.HasNext(), nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connections of organization with ID %q: %w", orgID, err)
+	}
+
+	var typedList []*management.OrganizationConnection
+	for _, item := range list {
+		typedList = append(typedList, item.(*management.OrganizationConnection))
+	}
+
+	return typedList, nil
+}
+
+func (c *cli) orgConnectionPickerOptions(ctx context.Context, orgID string) (pickerOptions, error) {
+	connections, err := c.getOrgConnections(ctx, orgID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, connection := range connections {
+		value := connection.GetConnectionID()
+		label := fmt.Sprintf(
+			"%s [%s] %s",
+			connection.GetConnection().GetName(),
+			connection.GetConnection().GetStrategy(),
+			ansi.Faint("("+value+")"),
+		)
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no connections enabled for the organization. Enable one by running: `auth0 orgs connections enable`")
+	}
+
+	return opts, nil
+}
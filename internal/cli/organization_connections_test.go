@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestOrganizationConnectionsListCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		Connections(gomock.Any(), "org_1", gomock.Any()).
+		Return(&management.OrganizationConnectionList{
+			OrganizationConnections: []*management.OrganizationConnection{
+				{
+					ConnectionID:            auth0.String("con_1"),
+					AssignMembershipOnLogin: auth0.Bool(true),
+					ShowAsButton:            auth0.Bool(false),
+					Connection: &management.OrganizationConnectionDetails{
+						Name:     auth0.String("Username-Password-Authentication"),
+						Strategy: auth0.String("auth0"),
+					},
+				},
+			},
+		}, nil)
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+		api:      &auth0.API{Organization: organizationAPI},
+	}
+
+	cmd := listConnectionsOrganizationCmd(cli)
+	cmd.SetArgs([]string{"org_1"})
+	require.NoError(t, cmd.Execute())
+
+	var connections []management.OrganizationConnection
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &connections))
+	require.Len(t, connections, 1)
+	assert.Equal(t, "con_1", connections[0].GetConnectionID())
+	assert.True(t, connections[0].GetAssignMembershipOnLogin())
+}
+
+func TestOrganizationConnectionsEnableCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		AddConnection(gomock.Any(), "org_1", &management.OrganizationConnection{
+			ConnectionID:            auth0.String("con_1"),
+			AssignMembershipOnLogin: auth0.Bool(true),
+			ShowAsButton:            auth0.Bool(true),
+		}).
+		Return(nil)
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Organization: organizationAPI},
+	}
+
+	cmd := enableConnectionOrganizationCmd(cli)
+	cmd.SetArgs([]string{"org_1", "--connection-id", "con_1", "--assign-membership-on-login"})
+	require.NoError(t, cmd.Execute())
+}
+
+func TestOrganizationConnectionsUpdateCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		Connection(gomock.Any(), "org_1", "con_1").
+		Return(&management.OrganizationConnection{
+			ConnectionID:            auth0.String("con_1"),
+			AssignMembershipOnLogin: auth0.Bool(true),
+			ShowAsButton:            auth0.Bool(true),
+		}, nil)
+	// The settings that aren't passed as flags are kept unchanged.
+	organizationAPI.EXPECT().
+		UpdateConnection(gomock.Any(), "org_1", "con_1", &management.OrganizationConnection{
+			AssignMembershipOnLogin: auth0.Bool(true),
+			ShowAsButton:            auth0.Bool(false),
+		}).
+		Return(nil)
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Organization: organizationAPI},
+	}
+
+	cmd := updateConnectionOrganizationCmd(cli)
+	cmd.SetArgs([]string{"org_1", "--connection-id", "con_1", "--show-as-button=false"})
+	require.NoError(t, cmd.Execute())
+}
+
+func TestOrganizationConnectionsDisableCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().DeleteConnection(gomock.Any(), "org_1", "con_1").Return(nil)
+	organizationAPI.EXPECT().DeleteConnection(gomock.Any(), "org_1", "con_2").Return(nil)
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Organization: organizationAPI},
+	}
+
+	cmd := disableConnectionOrganizationCmd(cli)
+	cmd.SetArgs([]string{"org_1", "--connection-id", "con_1,con_2", "--force"})
+	require.NoError(t, cmd.Execute())
+}
@@ -0,0 +1,479 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+const discoveryDomainStatusVerified = "verified"
+
+var (
+	discoveryDomainID = Flag{
+		Name:       "Domain ID",
+		LongForm:   "domain-id",
+		ShortForm:  "i",
+		Help:       "ID of the discovery domain.",
+		IsRequired: true,
+	}
+
+	discoveryDomainName = Flag{
+		Name:       "Domain",
+		LongForm:   "domain",
+		ShortForm:  "d",
+		Help:       "Domain name, such as acme.com, used to discover the organization from the email of its users.",
+		IsRequired: true,
+	}
+
+	discoveryDomainUseForDiscovery = Flag{
+		Name:         "Use For Discovery",
+		LongForm:     "use-for-discovery",
+		ShortForm:    "u",
+		Help:         "Whether to use the domain for organization discovery during login.",
+		AlwaysPrompt: true,
+	}
+
+	// lookupTXT resolves the DNS TXT records of a host when verifying a discovery domain.
+	lookupTXT = net.DefaultResolver.LookupTXT
+)
+
+func domainsOrganizationCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "domains",
+		Aliases: []string{"discovery-domains"},
+		Short:   "Manage discovery domains of an organization",
+		Long: "Manage discovery domains of an organization. " +
+			"Discovery domains enable home realm discovery, prompting users to log in to the organization " +
+			"matching the domain of their email. Each domain must be verified with a DNS TXT record before being used.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listDomainsOrganizationCmd(cli))
+	cmd.AddCommand(showDomainOrganizationCmd(cli))
+	cmd.AddCommand(createDomainOrganizationCmd(cli))
+	cmd.AddCommand(verifyDomainOrganizationCmd(cli))
+	cmd.AddCommand(updateDomainOrganizationCmd(cli))
+	cmd.AddCommand(deleteDomainOrganizationCmd(cli))
+
+	return cmd
+}
+
+func listDomainsOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List discovery domains of an organization",
+		Long: "List the discovery domains of an organization.\n\n" +
+			"To list interactively, use `auth0 orgs domains list` with no flags.\n\n" +
+			"To list non-interactively, supply the organization id through the flags.",
+		Example: `  auth0 orgs domains list
+  auth0 orgs domains ls --org-id <org-id>
+  auth0 orgs domains ls --org-id <org-id> --json
+  auth0 orgs domains ls --org-id <org-id> --json-compact
+  auth0 orgs domains ls --org-id <org-id> --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := organizationIDFlag.Pick(cmd, &inputs.OrgID, cli.organizationPickerOptions); err != nil {
+				return err
+			}
+
+			var domains []*management.OrganizationDiscoveryDomain
+			if err := ansi.Waiting(func() (err error) {
+				domains, err = cli.getOrgDiscoveryDomains(cmd.Context(), inputs.OrgID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to list discovery domains of organization with ID %q: %w", inputs.OrgID, err)
+			}
+
+			cli.renderer.DiscoveryDomainList(domains)
+			return nil
+		},
+	}
+
+	organizationIDFlag.RegisterString(cmd, &inputs.OrgID, "")
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	return cmd
+}
+
+func showDomainOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID    string
+		DomainID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.NoArgs,
+		Short: "Show a discovery domain of an organization",
+		Long: "Display information about a discovery domain of an organization, " +
+			"including the DNS TXT record to publish to verify it.\n\n" +
+			"To show interactively, use `auth0 orgs domains show` with no flags.\n\n" +
+			"To show non-interactively, supply the organization id and domain id through the flags.",
+		Example: `  auth0 orgs domains show
+  auth0 orgs domains show --org-id <org-id>
+  auth0 orgs domains show --org-id <org-id> --domain-id <domain-id>
+  auth0 orgs domains show --org-id <org-id> -i <domain-id> --json
+  auth0 orgs domains show --org-id <org-id> -i <domain-id> --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.pickOrgDiscoveryDomain(cmd, &inputs.OrgID, &inputs.DomainID); err != nil {
+				return err
+			}
+
+			var domain *management.OrganizationDiscoveryDomain
+			if err := ansi.Waiting(func() (err error) {
+				domain, err = cli.api.Organization.DiscoveryDomain(cmd.Context(), inputs.OrgID, inputs.DomainID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read discovery domain with ID %q: %w", inputs.DomainID, err)
+			}
+
+			cli.renderer.DiscoveryDomainShow(domain)
+			return nil
+		},
+	}
+
+	organizationIDFlag.RegisterString(cmd, &inputs.OrgID, "")
+	discoveryDomainID.RegisterString(cmd, &inputs.DomainID, "")
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func createDomainOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID           string
+		Domain          string
+		UseForDiscovery bool
+	}
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Aliases: []string{"add"},
+		Args:    cobra.NoArgs,
+		Short:   "Create a discovery domain for an organization",
+		Long: "Create a discovery domain for an organization.\n\n" +
+			"The domain starts as pending. Publish the DNS TXT record shown once created, " +
+			"then run `auth0 orgs domains verify` to verify it.\n\n" +
+			"To create interactively, use `auth0 orgs domains create` with no flags.\n\n" +
+			"To create non-interactively, supply the organization id and the domain through the flags.",
+		Example: `  auth0 orgs domains create
+  auth0 orgs domains create --org-id <org-id>
+  auth0 orgs domains create --org-id <org-id> --domain acme.com
+  auth0 orgs domains create --org-id <org-id> --domain acme.com --use-for-discovery
+  auth0 orgs domains create --org-id <org-id> -d acme.com -u --json
+  auth0 orgs domains create --org-id <org-id> -d acme.com -u --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := organizationIDFlag.Pick(cmd, &inputs.OrgID, cli.organizationPickerOptions); err != nil {
+				return err
+			}
+
+			if err := discoveryDomainName.Ask(cmd, &inputs.Domain, nil); err != nil {
+				return err
+			}
+
+			domain := &management.OrganizationDiscoveryDomain{
+				Domain:                      auth0.String(inputs.Domain),
+				UseForOrganizationDiscovery: auth0.Bool(inputs.UseForDiscovery),
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.CreateDiscoveryDomain(cmd.Context(), inputs.OrgID, domain)
+			}); err != nil {
+				return fmt.Errorf("failed to create discovery domain for organization with ID %q: %w", inputs.OrgID, err)
+			}
+
+			cli.renderer.DiscoveryDomainCreate(domain)
+			return nil
+		},
+	}
+
+	organizationIDFlag.RegisterString(cmd, &inputs.OrgID, "")
+	discoveryDomainName.RegisterString(cmd, &inputs.Domain, "")
+	discoveryDomainUseForDiscovery.RegisterBool(cmd, &inputs.UseForDiscovery, false)
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func verifyDomainOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID    string
+		DomainID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Args:  cobra.NoArgs,
+		Short: "Verify a discovery domain of an organization",
+		Long: "Verify a discovery domain of an organization.\n\n" +
+			"The DNS TXT record shown by `auth0 orgs domains show` must be published before verifying the domain. " +
+			"The record is looked up first, so that a missing or mismatching record is reported before " +
+			"the domain is marked as verified.",
+		Example: `  auth0 orgs domains verify
+  auth0 orgs domains verify --org-id <org-id>
+  auth0 orgs domains verify --org-id <org-id> --domain-id <domain-id>
+  auth0 orgs domains verify --org-id <org-id> -i <domain-id> --json
+  auth0 orgs domains verify --org-id <org-id> -i <domain-id> --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.pickOrgDiscoveryDomain(cmd, &inputs.OrgID, &inputs.DomainID); err != nil {
+				return err
+			}
+
+			var domain *management.OrganizationDiscoveryDomain
+			if err := ansi.Waiting(func() (err error) {
+				domain, err = cli.api.Organization.DiscoveryDomain(cmd.Context(), inputs.OrgID, inputs.DomainID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read discovery domain with ID %q: %w", inputs.DomainID, err)
+			}
+
+			if domain.GetStatus() == discoveryDomainStatusVerified {
+				cli.renderer.Infof("The domain %s is already verified.", ansi.Bold(domain.GetDomain()))
+				cli.renderer.DiscoveryDomainShow(domain)
+				return nil
+			}
+
+			if err := ansi.Spinner("Looking up the DNS TXT record", func() error {
+				return checkDiscoveryDomainTXTRecord(cmd.Context(), domain)
+			}); err != nil {
+				return err
+			}
+
+			update := &management.OrganizationDiscoveryDomain{Status: auth0.String(discoveryDomainStatusVerified)}
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.UpdateDiscoveryDomain(cmd.Context(), inputs.OrgID, inputs.DomainID, update)
+			}); err != nil {
+				return fmt.Errorf("failed to verify discovery domain with ID %q: %w", inputs.DomainID, err)
+			}
+
+			domain.Status = update.Status
+
+			cli.renderer.DiscoveryDomainVerify(domain)
+			return nil
+		},
+	}
+
+	organizationIDFlag.RegisterString(cmd, &inputs.OrgID, "")
+	discoveryDomainID.RegisterString(cmd, &inputs.DomainID, "")
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func updateDomainOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID           string
+		DomainID        string
+		UseForDiscovery bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.NoArgs,
+		Short: "Update a discovery domain of an organization",
+		Long: "Update whether a discovery domain is used for organization discovery during login.\n\n" +
+			"To update interactively, use `auth0 orgs domains update` with no flags.\n\n" +
+			"To update non-interactively, supply the organization id, the domain id and the settings through the flags.",
+		Example: `  auth0 orgs domains update
+  auth0 orgs domains update --org-id <org-id> --domain-id <domain-id> --use-for-discovery
+  auth0 orgs domains update --org-id <org-id> --domain-id <domain-id> --use-for-discovery=false
+  auth0 orgs domains update --org-id <org-id> -i <domain-id> -u --json
+  auth0 orgs domains update --org-id <org-id> -i <domain-id> -u --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.pickOrgDiscoveryDomain(cmd, &inputs.OrgID, &inputs.DomainID); err != nil {
+				return err
+			}
+
+			var current *management.OrganizationDiscoveryDomain
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.api.Organization.DiscoveryDomain(cmd.Context(), inputs.OrgID, inputs.DomainID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read discovery domain with ID %q: %w", inputs.DomainID, err)
+			}
+
+			if !discoveryDomainUseForDiscovery.IsSet(cmd) {
+				inputs.UseForDiscovery = current.GetUseForOrganizationDiscovery()
+			}
+			if err := discoveryDomainUseForDiscovery.AskBoolU(cmd, &inputs.UseForDiscovery, current.UseForOrganizationDiscovery); err != nil {
+				return err
+			}
+
+			update := &management.OrganizationDiscoveryDomain{UseForOrganizationDiscovery: auth0.Bool(inputs.UseForDiscovery)}
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.UpdateDiscoveryDomain(cmd.Context(), inputs.OrgID, inputs.DomainID, update)
+			}); err != nil {
+				return fmt.Errorf("failed to update discovery domain with ID %q: %w", inputs.DomainID, err)
+			}
+
+			current.UseForOrganizationDiscovery = update.UseForOrganizationDiscovery
+
+			cli.renderer.DiscoveryDomainUpdate(current)
+			return nil
+		},
+	}
+
+	organizationIDFlag.RegisterString(cmd, &inputs.OrgID, "")
+	discoveryDomainID.RegisterString(cmd, &inputs.DomainID, "")
+	discoveryDomainUseForDiscovery.RegisterBoolU(cmd, &inputs.UseForDiscovery, false)
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func deleteDomainOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID     string
+		DomainIDs []string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Args:    cobra.NoArgs,
+		Short:   "Delete discovery domain(s) from an organization",
+		Long: "Delete discovery domain(s) from an organization.\n\n" +
+			"To delete interactively, use `auth0 orgs domains delete` with no flags.\n\n" +
+			"To delete non-interactively, supply the organization id, domain id(s) and " +
+			"the `--force` flag to skip confirmation.",
+		Example: `  auth0 orgs domains delete
+  auth0 orgs domains rm
+  auth0 orgs domains delete --org-id <org-id> --domain-id <domain-id>
+  auth0 orgs domains delete --org-id <org-id> --domain-id <domain-id1>,<domain-id2>
+  auth0 orgs domains delete --org-id <org-id> --domain-id <domain-id> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := organizationIDFlag.Pick(cmd, &inputs.OrgID, cli.organizationPickerOptions); err != nil {
+				return err
+			}
+
+			if err := discoveryDomainID.PickMany(cmd, &inputs.DomainIDs, func(ctx context.Context) (pickerOptions, error) {
+				return cli.discoveryDomainPickerOptions(ctx, inputs.OrgID)
+			}); err != nil {
+				return err
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			return ansi.ProgressBar("Deleting discovery domain(s)", inputs.DomainIDs, func(_ int, domainID string) error {
+				if domainID != "" {
+					if err := cli.api.Organization.DeleteDiscoveryDomain(cmd.Context(), inputs.OrgID, domainID); err != nil {
+						return fmt.Errorf("failed to delete discovery domain with ID %q from organization %q: %w", domainID, inputs.OrgID, err)
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	organizationIDFlag.RegisterString(cmd, &inputs.OrgID, "")
+	discoveryDomainID.RegisterStringSlice(cmd, &inputs.DomainIDs, nil)
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func (c *cli) getOrgDiscoveryDomains(ctx context.Context, orgID string) ([]*management.OrganizationDiscoveryDomain, error) {
+	var domains []*management.OrganizationDiscoveryDomain
+
+	opts := []management.RequestOption{management.Take(100)}
+	for {
+		list, err := c.api.Organization.DiscoveryDomains(ctx, orgID, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		domains = append(domains, list.Domains...)
+
+		if list.Next == "" || len(list.Domains) == 0 {
+			break
+		}
+
+		opts = []management.RequestOption{management.Take(100), management.From(list.Next)}
+	}
+
+	return domains, nil
+}
+
+func (c *cli) pickOrgDiscoveryDomain(cmd *cobra.Command, orgID, domainID *string) error {
+	if err := organizationIDFlag.Pick(cmd, orgID, c.organizationPickerOptions); err != nil {
+		return err
+	}
+
+	return discoveryDomainID.Pick(cmd, domainID, func(ctx context.Context) (pickerOptions, error) {
+		return c.discoveryDomainPickerOptions(ctx, *orgID)
+	})
+}
+
+func (c *cli) discoveryDomainPickerOptions(ctx context.Context, orgID string) (pickerOptions, error) {
+	domains, err := c.getOrgDiscoveryDomains(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, domain := range domains {
+		label := fmt.Sprintf("%s [%s] %s", domain.GetDomain(), domain.GetStatus(), ansi.Faint("("+domain.GetID()+")"))
+
+		opts = append(opts, pickerOption{value: domain.GetID(), label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no discovery domains to choose from. Create one by running: `auth0 orgs domains create`")
+	}
+
+	return opts, nil
+}
+
+// checkDiscoveryDomainTXTRecord ensures the TXT record proving the
+// ownership of the domain is published at its verification host.
+func checkDiscoveryDomainTXTRecord(ctx context.Context, domain *management.OrganizationDiscoveryDomain) error {
+	records, err := lookupTXT(ctx, domain.GetVerificationHost())
+
+	var dnsErr *net.DNSError
+	if err != nil && !(errors.As(err, &dnsErr) && dnsErr.IsNotFound) {
+		return fmt.Errorf("failed to look up the DNS TXT records of %q: %w", domain.GetVerificationHost(), err)
+	}
+
+	if !slices.Contains(records, domain.GetVerificationTXT()) {
+		return fmt.Errorf(
+			"no DNS TXT record on %q with the value %q was found. DNS changes can take a while to propagate, please try again later",
+			domain.GetVerificationHost(),
+			domain.GetVerificationTXT(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func stubLookupTXT(t *testing.T, records map[string][]string) {
+	original := lookupTXT
+	t.Cleanup(func() { lookupTXT = original })
+
+	lookupTXT = func(_ context.Context, host string) ([]string, error) {
+		if txt, ok := records[host]; ok {
+			return txt, nil
+		}
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+}
+
+func TestCheckDiscoveryDomainTXTRecord(t *testing.T) {
+	stubLookupTXT(t, map[string][]string{
+		"_auth0-challenge.acme.com": {"v=spf1 -all", "auth0-domain-verification=abc"},
+	})
+
+	domain := &management.OrganizationDiscoveryDomain{
+		VerificationHost: auth0.String("_auth0-challenge.acme.com"),
+		VerificationTXT:  auth0.String("auth0-domain-verification=abc"),
+	}
+	assert.NoError(t, checkDiscoveryDomainTXTRecord(context.Background(), domain))
+
+	domain.VerificationTXT = auth0.String("auth0-domain-verification=xyz")
+	assert.ErrorContains(t, checkDiscoveryDomainTXTRecord(context.Background(), domain), `no DNS TXT record on "_auth0-challenge.acme.com" with the value "auth0-domain-verification=xyz" was found`)
+
+	domain.VerificationHost = auth0.String("_auth0-challenge.example.com")
+	assert.ErrorContains(t, checkDiscoveryDomainTXTRecord(context.Background(), domain), "no DNS TXT record")
+}
+
+func TestOrganizationDomainsVerifyCmd(t *testing.T) {
+	stubLookupTXT(t, map[string][]string{
+		"_auth0-challenge.acme.com": {"auth0-domain-verification=abc"},
+	})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		DiscoveryDomain(gomock.Any(), "org_1", "dd_1").
+		Return(&management.OrganizationDiscoveryDomain{
+			ID:               auth0.String("dd_1"),
+			Domain:           auth0.String("acme.com"),
+			Status:           auth0.String("pending"),
+			VerificationHost: auth0.String("_auth0-challenge.acme.com"),
+			VerificationTXT:  auth0.String("auth0-domain-verification=abc"),
+		}, nil)
+	organizationAPI.EXPECT().
+		UpdateDiscoveryDomain(gomock.Any(), "org_1", "dd_1", &management.OrganizationDiscoveryDomain{Status: auth0.String("verified")}).
+		Return(nil)
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Organization: organizationAPI},
+	}
+
+	cmd := verifyDomainOrganizationCmd(cli)
+	cmd.SetArgs([]string{"--org-id", "org_1", "--domain-id", "dd_1"})
+	require.NoError(t, cmd.Execute())
+}
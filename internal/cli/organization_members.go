@@ -0,0 +1,356 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var (
+	organizationMembers = Flag{
+		Name:       "Members",
+		LongForm:   "members",
+		ShortForm:  "m",
+		Help:       "Comma-separated list of user IDs of the members.",
+		IsRequired: true,
+	}
+
+	organizationMemberUserID = Flag{
+		Name:       "User ID",
+		LongForm:   "user-id",
+		ShortForm:  "u",
+		Help:       "User ID of the organization member.",
+		IsRequired: true,
+	}
+
+	organizationMemberRoles = Flag{
+		Name:       "Roles",
+		LongForm:   "roles",
+		ShortForm:  "r",
+		Help:       "Comma-separated list of role IDs.",
+		IsRequired: true,
+	}
+)
+
+func addMembersOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID   string
+		Members []string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Add members to an organization",
+		Long: "Add existing users as members of an organization.\n\n" +
+			"To add interactively, use `auth0 orgs members add` with no arguments.\n\n" +
+			"To add non-interactively, supply the organization id and the user id(s) of the members.",
+		Example: `  auth0 orgs members add
+  auth0 orgs members add <org-id>
+  auth0 orgs members add <org-id> --members <user-id>
+  auth0 orgs members add <org-id> -m <user-id1>,<user-id2>,<user-id3>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.pickOrgID(cmd, args, &inputs.OrgID); err != nil {
+				return err
+			}
+
+			if err := organizationMembers.AskMany(cmd, &inputs.Members, nil); err != nil {
+				return err
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.AddMembers(cmd.Context(), inputs.OrgID, inputs.Members)
+			}); err != nil {
+				return fmt.Errorf("failed to add members to organization with ID %q: %w", inputs.OrgID, err)
+			}
+
+			cli.renderer.Infof("Added %d member(s) to organization with ID %q.", len(inputs.Members), inputs.OrgID)
+			return nil
+		},
+	}
+
+	organizationMembers.RegisterStringSlice(cmd, &inputs.Members, nil)
+
+	return cmd
+}
+
+func removeMembersOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID   string
+		Members []string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove",
+		Aliases: []string{"rm"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Remove members from an organization",
+		Long: "Remove members from an organization. The users themselves are not deleted.\n\n" +
+			"To remove interactively, use `auth0 orgs members remove` with no arguments.\n\n" +
+			"To remove non-interactively, supply the organization id, the user id(s) of the members and " +
+			"the `--force` flag to skip confirmation.",
+		Example: `  auth0 orgs members remove
+  auth0 orgs members rm <org-id>
+  auth0 orgs members remove <org-id> --members <user-id>
+  auth0 orgs members remove <org-id> -m <user-id1>,<user-id2>,<user-id3>
+  auth0 orgs members rm <org-id> -m <user-id> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.pickOrgID(cmd, args, &inputs.OrgID); err != nil {
+				return err
+			}
+
+			if err := organizationMembers.PickMany(cmd, &inputs.Members, func(ctx context.Context) (pickerOptions, error) {
+				return cli.orgMemberPickerOptions(ctx, inputs.OrgID)
+			}); err != nil {
+				return err
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.DeleteMembers(cmd.Context(), inputs.OrgID, inputs.Members)
+			}); err != nil {
+				return fmt.Errorf("failed to remove members from organization with ID %q: %w", inputs.OrgID, err)
+			}
+
+			cli.renderer.Infof("Removed %d member(s) from organization with ID %q.", len(inputs.Members), inputs.OrgID)
+			return nil
+		},
+	}
+
+	organizationMembers.RegisterStringSlice(cmd, &inputs.Members, nil)
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func rolesMembersOrganizationCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles",
+		Short: "Manage roles of an organization member",
+		Long: "Manage the roles assigned to a member of an organization, " +
+			"which are applied when the user logs in through the organization.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(assignMemberRolesOrganizationCmd(cli))
+	cmd.AddCommand(removeMemberRolesOrganizationCmd(cli))
+
+	return cmd
+}
+
+func assignMemberRolesOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID  string
+		UserID string
+		Roles  []string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "assign",
+		Aliases: []string{"add"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Assign roles to an organization member",
+		Long: "Assign roles to a member of an organization.\n\n" +
+			"To assign interactively, use `auth0 orgs members roles assign` with no arguments.\n\n" +
+			"To assign non-interactively, supply the organization id, the user id of the member and the role id(s).",
+		Example: `  auth0 orgs members roles assign
+  auth0 orgs members roles add <org-id>
+  auth0 orgs members roles assign <org-id> --user-id <user-id> --roles <role-id>
+  auth0 orgs members roles assign <org-id> -u <user-id> -r <role-id1>,<role-id2>
+  auth0 orgs members roles add <org-id> -u <user-id> -r <role-id> --json
+  auth0 orgs members roles add <org-id> -u <user-id> -r <role-id> --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.pickOrgMember(cmd, args, &inputs.OrgID, &inputs.UserID); err != nil {
+				return err
+			}
+
+			if err := organizationMemberRoles.PickMany(cmd, &inputs.Roles, cli.rolePickerOptions); err != nil {
+				return err
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.AssignMemberRoles(cmd.Context(), inputs.OrgID, inputs.UserID, inputs.Roles)
+			}); err != nil {
+				return fmt.Errorf(
+					"failed to assign roles to member %q of organization with ID %q: %w",
+					inputs.UserID, inputs.OrgID, err,
+				)
+			}
+
+			return cli.renderOrgMemberRoles(cmd.Context(), inputs.OrgID, inputs.UserID)
+		},
+	}
+
+	organizationMemberUserID.RegisterString(cmd, &inputs.UserID, "")
+	organizationMemberRoles.Help = "Comma-separated list of role IDs to assign to the member."
+	organizationMemberRoles.RegisterStringSlice(cmd, &inputs.Roles, nil)
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func removeMemberRolesOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		OrgID  string
+		UserID string
+		Roles  []string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove",
+		Aliases: []string{"rm"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Remove roles from an organization member",
+		Long: "Remove roles from a member of an organization.\n\n" +
+			"To remove interactively, use `auth0 orgs members roles remove` with no arguments.\n\n" +
+			"To remove non-interactively, supply the organization id, the user id of the member, the role id(s) and " +
+			"the `--force` flag to skip confirmation.",
+		Example: `  auth0 orgs members roles remove
+  auth0 orgs members roles rm <org-id>
+  auth0 orgs members roles remove <org-id> --user-id <user-id> --roles <role-id>
+  auth0 orgs members roles remove <org-id> -u <user-id> -r <role-id1>,<role-id2> --force
+  auth0 orgs members roles rm <org-id> -u <user-id> -r <role-id> --force --json
+  auth0 orgs members roles rm <org-id> -u <user-id> -r <role-id> --force --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.pickOrgMember(cmd, args, &inputs.OrgID, &inputs.UserID); err != nil {
+				return err
+			}
+
+			if err := organizationMemberRoles.PickMany(cmd, &inputs.Roles, func(ctx context.Context) (pickerOptions, error) {
+				return cli.orgMemberRolePickerOptions(ctx, inputs.OrgID, inputs.UserID)
+			}); err != nil {
+				return err
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.DeleteMemberRoles(cmd.Context(), inputs.OrgID, inputs.UserID, inputs.Roles)
+			}); err != nil {
+				return fmt.Errorf(
+					"failed to remove roles from member %q of organization with ID %q: %w",
+					inputs.UserID, inputs.OrgID, err,
+				)
+			}
+
+			return cli.renderOrgMemberRoles(cmd.Context(), inputs.OrgID, inputs.UserID)
+		},
+	}
+
+	organizationMemberUserID.RegisterString(cmd, &inputs.UserID, "")
+	organizationMemberRoles.Help = "Comma-separated list of role IDs to remove from the member."
+	organizationMemberRoles.RegisterStringSlice(cmd, &inputs.Roles, nil)
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+// pickOrgID resolves the organization from the arguments, or a picker.
+func (c *cli) pickOrgID(cmd *cobra.Command, args []string, orgID *string) error {
+	if len(args) > 0 {
+		*orgID = args[0]
+		return nil
+	}
+
+	return organizationID.Pick(cmd, orgID, c.organizationPickerOptions)
+}
+
+// pickOrgMember resolves the organization from the arguments, or a picker,
+// and then the member through the --user-id flag or a picker.
+func (c *cli) pickOrgMember(cmd *cobra.Command, args []string, orgID, userID *string) error {
+	if err := c.pickOrgID(cmd, args, orgID); err != nil {
+		return err
+	}
+
+	return organizationMemberUserID.Pick(cmd, userID, func(ctx context.Context) (pickerOptions, error) {
+		return c.orgMemberPickerOptions(ctx, *orgID)
+	})
+}
+
+func (c *cli) renderOrgMemberRoles(ctx context.Context, orgID, userID string) error {
+	var roleList *management.OrganizationMemberRoleList
+	if err := ansi.Waiting(func() (err error) {
+		roleList, err = c.api.Organization.MemberRoles(ctx, orgID, userID)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to read roles of member %q of organization with ID %q: %w", userID, orgID, err)
+	}
+
+	roleMap := make(map[string]management.OrganizationMemberRole, len(roleList.Roles))
+	for _, role := range roleList.Roles {
+		roleMap[role.GetID()] = role
+	}
+
+	c.renderer.OrganizationMemberRoleList(c.convertOrgRolesToManagementRoles(roleMap))
+	return nil
+}
+
+func (c *cli) orgMemberPickerOptions(ctx context.Context, orgID string) (pickerOptions, error) {
+	members, err := c.getOrgMembers(ctx, orgID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	sortMembers(members)
+
+	var opts pickerOptions
+	for _, member := range members {
+		value := member.GetUserID()
+		label := fmt.Sprintf("%s <%s> %s", member.GetName(), member.GetEmail(), ansi.Faint("("+value+")"))
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no members in the organization. Add one by running: `auth0 orgs members add`")
+	}
+
+	return opts, nil
+}
+
+func (c *cli) orgMemberRolePickerOptions(ctx context.Context, orgID, userID string) (pickerOptions, error) {
+	roleList, err := c.api.Organization.MemberRoles(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, role := range roleList.Roles {
+		value := role.GetID()
+		label := fmt.Sprintf("%s %s", role.GetName(), ansi.Faint("("+value+")"))
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("the member currently has no roles assigned in the organization")
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestOrganizationMembersAddCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		AddMembers(gomock.Any(), "org_1", []string{"auth0|1", "auth0|2"}).
+		Return(nil)
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Organization: organizationAPI},
+	}
+
+	cmd := addMembersOrganizationCmd(cli)
+	cmd.SetArgs([]string{"org_1", "--members", "auth0|1,auth0|2"})
+	require.NoError(t, cmd.Execute())
+}
+
+func TestOrganizationMembersRemoveCmd(t *testing.T) {
+	t.Run("it removes the members when forced", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+		organizationAPI.EXPECT().
+			DeleteMembers(gomock.Any(), "org_1", []string{"auth0|1"}).
+			Return(nil)
+
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			api:      &auth0.API{Organization: organizationAPI},
+		}
+
+		cmd := removeMembersOrganizationCmd(cli)
+		cmd.SetArgs([]string{"org_1", "--members", "auth0|1", "--force"})
+		require.NoError(t, cmd.Execute())
+	})
+
+	t.Run("it refuses to remove the members without confirmation in agent mode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cli := &cli{
+			renderer:  &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			api:       &auth0.API{Organization: mock.NewMockOrganizationAPI(ctrl)},
+			agentMode: true,
+		}
+
+		cmd := removeMembersOrganizationCmd(cli)
+		cmd.SetArgs([]string{"org_1", "--members", "auth0|1"})
+		assert.ErrorIs(t, cmd.Execute(), errDestructiveNoConfirm)
+	})
+}
+
+func TestOrganizationMemberRolesAssignCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		AssignMemberRoles(gomock.Any(), "org_1", "auth0|1", []string{"rol_1", "rol_2"}).
+		Return(nil)
+	organizationAPI.EXPECT().
+		MemberRoles(gomock.Any(), "org_1", "auth0|1").
+		Return(&management.OrganizationMemberRoleList{
+			Roles: []management.OrganizationMemberRole{
+				{ID: auth0.String("rol_2"), Name: auth0.String("writer")},
+				{ID: auth0.String("rol_1"), Name: auth0.String("Reader")},
+			},
+		}, nil)
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+		api:      &auth0.API{Organization: organizationAPI},
+	}
+
+	cmd := assignMemberRolesOrganizationCmd(cli)
+	cmd.SetArgs([]string{"org_1", "--user-id", "auth0|1", "--roles", "rol_1,rol_2"})
+	require.NoError(t, cmd.Execute())
+
+	var roles []management.Role
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &roles))
+	require.Len(t, roles, 2)
+	assert.Equal(t, "Reader", roles[0].GetName())
+	assert.Equal(t, "writer", roles[1].GetName())
+}
+
+func TestOrganizationMemberRolesRemoveCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		DeleteMemberRoles(gomock.Any(), "org_1", "auth0|1", []string{"rol_1"}).
+		Return(nil)
+	organizationAPI.EXPECT().
+		MemberRoles(gomock.Any(), "org_1", "auth0|1").
+		Return(&management.OrganizationMemberRoleList{}, nil)
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Organization: organizationAPI},
+	}
+
+	cmd := removeMemberRolesOrganizationCmd(cli)
+	cmd.SetArgs([]string{"org_1", "-u", "auth0|1", "-r", "rol_1", "--force"})
+	require.NoError(t, cmd.Execute())
+}
@@ -0,0 +1,708 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+var (
+	organizationProvisionFile = Flag{
+		Name:       "File",
+		LongForm:   "file",
+		ShortForm:  "f",
+		Help:       "YAML manifest describing the organization to provision.",
+		IsRequired: true,
+	}
+
+	organizationProvisionDryRun = Flag{
+		Name:     "Dry Run",
+		LongForm: "dry-run",
+		Help:     "Show the actions needed to reconcile the organization with the manifest without applying them.",
+	}
+)
+
+// orgManifest describes an organization along with its connections, members and invitations.
+type orgManifest struct {
+	Name        string                  `yaml:"name"`
+	DisplayName string                  `yaml:"display_name"`
+	Branding    *orgManifestBranding    `yaml:"branding"`
+	Metadata    map[string]string       `yaml:"metadata"`
+	Connections []orgManifestConnection `yaml:"connections"`
+	Members     []orgManifestMember     `yaml:"members"`
+	Invitations []orgManifestInvitation `yaml:"invitations"`
+}
+
+type orgManifestBranding struct {
+	LogoURL             string `yaml:"logo_url"`
+	PrimaryColor        string `yaml:"primary_color"`
+	PageBackgroundColor string `yaml:"page_background_color"`
+}
+
+// orgManifestConnection references a connection by name or ID.
+type orgManifestConnection struct {
+	Name                    string `yaml:"name"`
+	AssignMembershipOnLogin *bool  `yaml:"assign_membership_on_login"`
+	ShowAsButton            *bool  `yaml:"show_as_button"`
+}
+
+// orgManifestMember references an existing user by ID or email. Roles are referenced by name or ID.
+type orgManifestMember struct {
+	UserID string   `yaml:"user_id"`
+	Email  string   `yaml:"email"`
+	Roles  []string `yaml:"roles"`
+}
+
+type orgManifestInvitation struct {
+	Email       string   `yaml:"email"`
+	InviterName string   `yaml:"inviter_name"`
+	ClientID    string   `yaml:"client_id"`
+	Connection  string   `yaml:"connection"`
+	Roles       []string `yaml:"roles"`
+	TTLSec      int      `yaml:"ttl_sec"`
+	SendEmail   *bool    `yaml:"send_email"`
+}
+
+// orgProvisioner reconciles an organization with its manifest,
+// and records every action taken, or planned with dryRun.
+type orgProvisioner struct {
+	cli     *cli
+	dryRun  bool
+	actions []display.OrganizationProvisionAction
+
+	// The IDs of the connections, roles and users referenced in the manifest.
+	connectionIDs map[string]string
+	roleIDs       map[string]string
+	userIDs       map[string]string
+}
+
+func provisionOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		File   string
+		DryRun bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "provision",
+		Args:  cobra.NoArgs,
+		Short: "Provision an organization from a manifest",
+		Long: "Create or update an organization from a YAML manifest, along with its connections, members, " +
+			"member roles and invitations.\n\n" +
+			"Provisioning is idempotent: only the differences between the manifest and the tenant are applied, " +
+			"so the same manifest can be provisioned again safely. Connections, members and invitations that " +
+			"are not in the manifest are left untouched. Every action is reported, and `--dry-run` reports " +
+			"them without applying any change.\n\n" +
+			"Connections and roles are referenced by name or ID, and members by user ID or email:\n\n" +
+			"```yaml\n" +
+			"name: acme\n" +
+			"display_name: Acme Inc.\n" +
+			"branding:\n" +
+			"  logo_url: https://acme.com/logo.png\n" +
+			"  primary_color: \"#635DFF\"\n" +
+			"  page_background_color: \"#2A2E35\"\n" +
+			"metadata:\n" +
+			"  plan: enterprise\n" +
+			"connections:\n" +
+			"  - name: acme-saml\n" +
+			"    assign_membership_on_login: true\n" +
+			"    show_as_button: true\n" +
+			"members:\n" +
+			"  - email: admin@acme.com\n" +
+			"    roles: [admin]\n" +
+			"  - user_id: auth0|62d9a47e5c4a2e1d8e6d2b1c\n" +
+			"invitations:\n" +
+			"  - email: jane@acme.com\n" +
+			"    inviter_name: Customer Success\n" +
+			"    client_id: <client-id>\n" +
+			"    connection: acme-saml\n" +
+			"    roles: [viewer]\n" +
+			"```",
+		Example: `  auth0 orgs provision --file customer.yaml
+  auth0 orgs provision -f customer.yaml --dry-run
+  auth0 orgs provision -f customer.yaml --json
+  auth0 orgs provision -f customer.yaml --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, err := readOrgManifest(inputs.File)
+			if err != nil {
+				return err
+			}
+
+			provisioner := &orgProvisioner{cli: cli, dryRun: inputs.DryRun}
+			err = provisioner.provision(cmd.Context(), manifest)
+
+			// The actions taken so far are reported even if provisioning failed halfway.
+			cli.renderer.OrganizationProvision(provisioner.actions, inputs.DryRun)
+
+			return err
+		},
+	}
+
+	organizationProvisionFile.RegisterString(cmd, &inputs.File, "")
+	organizationProvisionDryRun.RegisterBool(cmd, &inputs.DryRun, false)
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func readOrgManifest(path string) (*orgManifest, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest %q: %w", path, err)
+	}
+
+	var manifest orgManifest
+	if err := yaml.UnmarshalStrict(content, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %q: %w", path, err)
+	}
+
+	if err := manifest.validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest %q: %w", path, err)
+	}
+
+	return &manifest, nil
+}
+
+func (m *orgManifest) validate() error {
+	if m.Name == "" {
+		return errors.New("the organization name is required")
+	}
+
+	for i, connection := range m.Connections {
+		if connection.Name == "" {
+			return fmt.Errorf("connection #%d has no name", i+1)
+		}
+	}
+
+	for i, member := range m.Members {
+		if member.UserID == "" && member.Email == "" {
+			return fmt.Errorf("member #%d has neither a user_id nor an email", i+1)
+		}
+	}
+
+	for i, invitation := range m.Invitations {
+		if invitation.Email == "" || invitation.InviterName == "" || invitation.ClientID == "" {
+			return fmt.Errorf("invitation #%d requires an email, an inviter_name and a client_id", i+1)
+		}
+	}
+
+	return nil
+}
+
+// key identifies the member in the manifest and in the report.
+func (m orgManifestMember) key() string {
+	if m.UserID != "" {
+		return m.UserID
+	}
+	return m.Email
+}
+
+// provision resolves the references of the manifest before changing anything,
+// so that a manifest referencing unknown resources is rejected as a whole.
+func (p *orgProvisioner) provision(ctx context.Context, manifest *orgManifest) error {
+	if err := ansi.Waiting(func() error {
+		return p.resolve(ctx, manifest)
+	}); err != nil {
+		return err
+	}
+
+	org, isNew, err := p.reconcileOrganization(ctx, manifest)
+	if err != nil {
+		return err
+	}
+
+	if err := p.reconcileConnections(ctx, org, isNew, manifest.Connections); err != nil {
+		return err
+	}
+
+	members, err := p.reconcileMembers(ctx, org, isNew, manifest.Members)
+	if err != nil {
+		return err
+	}
+
+	return p.reconcileInvitations(ctx, org, isNew, members, manifest.Invitations)
+}
+
+func (p *orgProvisioner) resolve(ctx context.Context, manifest *orgManifest) error {
+	p.connectionIDs = make(map[string]string)
+	p.roleIDs = make(map[string]string)
+	p.userIDs = make(map[string]string)
+
+	connections := make([]string, 0, len(manifest.Connections))
+	var roles []string
+	for _, connection := range manifest.Connections {
+		connections = append(connections, connection.Name)
+	}
+	for _, member := range manifest.Members {
+		roles = append(roles, member.Roles...)
+	}
+	for _, invitation := range manifest.Invitations {
+		if invitation.Connection != "" {
+			connections = append(connections, invitation.Connection)
+		}
+		roles = append(roles, invitation.Roles...)
+	}
+
+	for _, name := range connections {
+		if _, ok := p.connectionIDs[name]; ok {
+			continue
+		}
+
+		var (
+			connection *management.Connection
+			err        error
+		)
+		if strings.HasPrefix(name, "con_") {
+			connection, err = p.cli.api.Connection.Read(ctx, name)
+		} else {
+			connection, err = p.cli.api.Connection.ReadByName(ctx, name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find connection %q: %w", name, err)
+		}
+		p.connectionIDs[name] = connection.GetID()
+	}
+
+	for _, name := range roles {
+		if _, ok := p.roleIDs[name]; ok {
+			continue
+		}
+
+		id, err := p.resolveRole(ctx, name)
+		if err != nil {
+			return err
+		}
+		p.roleIDs[name] = id
+	}
+
+	for _, member := range manifest.Members {
+		if member.UserID != "" {
+			p.userIDs[member.key()] = member.UserID
+			continue
+		}
+
+		users, err := p.cli.api.User.ListByEmail(ctx, member.Email)
+		if err != nil {
+			return fmt.Errorf("failed to find user with email %q: %w", member.Email, err)
+		}
+		if len(users) != 1 {
+			return fmt.Errorf(
+				"found %d users with email %q, please reference the member by user_id instead",
+				len(users), member.Email,
+			)
+		}
+		p.userIDs[member.key()] = users[0].GetID()
+	}
+
+	return nil
+}
+
+func (p *orgProvisioner) resolveRole(ctx context.Context, name string) (string, error) {
+	if strings.HasPrefix(name, "rol_") {
+		return name, nil
+	}
+
+	list, err := p.cli.api.Role.List(ctx, management.Parameter("name_filter", name))
+	if err != nil {
+		return "", fmt.Errorf("failed to find role %q: %w", name, err)
+	}
+
+	// The name filter is a case-insensitive partial match.
+	for _, role := range list.Roles {
+		if role.GetName() == name {
+			return role.GetID(), nil
+		}
+	}
+
+	return "", fmt.Errorf("failed to find role %q", name)
+}
+
+func (p *orgProvisioner) record(resource, target, action, detail string) {
+	p.actions = append(p.actions, display.OrganizationProvisionAction{
+		Resource: resource,
+		Target:   target,
+		Action:   action,
+		Detail:   detail,
+	})
+}
+
+// apply runs the change, unless it's a dry run.
+func (p *orgProvisioner) apply(fn func() error) error {
+	if p.dryRun {
+		return nil
+	}
+
+	return ansi.Waiting(fn)
+}
+
+func (p *orgProvisioner) reconcileOrganization(
+	ctx context.Context,
+	manifest *orgManifest,
+) (*management.Organization, bool, error) {
+	var current *management.Organization
+	err := ansi.Waiting(func() (err error) {
+		current, err = p.cli.api.Organization.ReadByName(ctx, manifest.Name)
+		return err
+	})
+
+	var mErr management.Error
+	if errors.As(err, &mErr) && mErr.Status() == http.StatusNotFound {
+		org := manifest.organization(nil)
+		if err := p.apply(func() error {
+			return p.cli.api.Organization.Create(ctx, org)
+		}); err != nil {
+			return nil, false, fmt.Errorf("failed to create organization with name %q: %w", manifest.Name, err)
+		}
+
+		p.record("organization", manifest.Name, "create", org.GetID())
+		return org, true, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read organization with name %q: %w", manifest.Name, err)
+	}
+
+	update, changes := manifest.organizationUpdate(current)
+	if len(changes) == 0 {
+		p.record("organization", manifest.Name, display.OrganizationProvisionUnchanged, current.GetID())
+		return current, false, nil
+	}
+
+	if err := p.apply(func() error {
+		return p.cli.api.Organization.Update(ctx, current.GetID(), update)
+	}); err != nil {
+		return nil, false, fmt.Errorf("failed to update organization with name %q: %w", manifest.Name, err)
+	}
+
+	p.record("organization", manifest.Name, "update", strings.Join(changes, ", "))
+	return current, false, nil
+}
+
+// organization returns the organization described by the manifest, on top of the current one if any.
+func (m *orgManifest) organization(current *management.Organization) *management.Organization {
+	org := &management.Organization{Name: auth0.String(m.Name)}
+
+	if m.DisplayName != "" {
+		org.DisplayName = auth0.String(m.DisplayName)
+	}
+
+	if m.Branding != nil {
+		branding := management.OrganizationBranding{}
+		colors := make(map[string]string)
+		if current != nil && current.Branding != nil {
+			branding.LogoURL = current.Branding.LogoURL
+			for key, value := range current.Branding.GetColors() {
+				colors[key] = value
+			}
+		}
+
+		if m.Branding.LogoURL != "" {
+			branding.LogoURL = auth0.String(m.Branding.LogoURL)
+		}
+		if m.Branding.PrimaryColor != "" {
+			colors[apiOrganizationColorPrimary] = m.Branding.PrimaryColor
+		}
+		if m.Branding.PageBackgroundColor != "" {
+			colors[apiOrganizationColorPageBackground] = m.Branding.PageBackgroundColor
+		}
+		if len(colors) > 0 {
+			branding.Colors = &colors
+		}
+
+		org.Branding = &branding
+	}
+
+	if len(m.Metadata) > 0 {
+		metadata := make(map[string]string)
+		if current != nil {
+			for key, value := range current.GetMetadata() {
+				metadata[key] = value
+			}
+		}
+		for key, value := range m.Metadata {
+			metadata[key] = value
+		}
+		org.Metadata = &metadata
+	}
+
+	return org
+}
+
+// organizationUpdate returns the update of the current organization, with the names of the
+// changed fields. Fields that are not in the manifest are not changed.
+func (m *orgManifest) organizationUpdate(current *management.Organization) (*management.Organization, []string) {
+	desired := m.organization(current)
+	update := &management.Organization{}
+
+	var changes []string
+	if desired.DisplayName != nil && desired.GetDisplayName() != current.GetDisplayName() {
+		update.DisplayName = desired.DisplayName
+		changes = append(changes, "display_name")
+	}
+
+	if desired.Branding != nil && (desired.Branding.GetLogoURL() != current.GetBranding().GetLogoURL() ||
+		!maps.Equal(desired.Branding.GetColors(), current.GetBranding().GetColors())) {
+		update.Branding = desired.Branding
+		changes = append(changes, "branding")
+	}
+
+	if desired.Metadata != nil && !maps.Equal(desired.GetMetadata(), current.GetMetadata()) {
+		update.Metadata = desired.Metadata
+		changes = append(changes, "metadata")
+	}
+
+	return update, changes
+}
+
+func (p *orgProvisioner) reconcileConnections(
+	ctx context.Context,
+	org *management.Organization,
+	isNew bool,
+	connections []orgManifestConnection,
+) error {
+	if len(connections) == 0 {
+		return nil
+	}
+
+	current := make(map[string]*management.OrganizationConnection)
+	if !isNew {
+		list, err := p.cli.getOrgConnections(ctx, org.GetID(), 0)
+		if err != nil {
+			return err
+		}
+		for _, connection := range list {
+			current[connection.GetConnectionID()] = connection
+		}
+	}
+
+	for _, connection := range connections {
+		id := p.connectionIDs[connection.Name]
+
+		existing, ok := current[id]
+		if !ok {
+			enable := &management.OrganizationConnection{
+				ConnectionID:            auth0.String(id),
+				AssignMembershipOnLogin: auth0.Bool(false),
+				ShowAsButton:            auth0.Bool(true),
+			}
+			if connection.AssignMembershipOnLogin != nil {
+				enable.AssignMembershipOnLogin = connection.AssignMembershipOnLogin
+			}
+			if connection.ShowAsButton != nil {
+				enable.ShowAsButton = connection.ShowAsButton
+			}
+
+			if err := p.apply(func() error {
+				return p.cli.api.Organization.AddConnection(ctx, org.GetID(), enable)
+			}); err != nil {
+				return fmt.Errorf("failed to enable connection %q: %w", connection.Name, err)
+			}
+
+			p.record("connection", connection.Name, "enable", "")
+			continue
+		}
+
+		update := &management.OrganizationConnection{}
+		var changes []string
+		if connection.AssignMembershipOnLogin != nil &&
+			*connection.AssignMembershipOnLogin != existing.GetAssignMembershipOnLogin() {
+			update.AssignMembershipOnLogin = connection.AssignMembershipOnLogin
+			changes = append(changes, "assign_membership_on_login")
+		}
+		if connection.ShowAsButton != nil && *connection.ShowAsButton != existing.GetShowAsButton() {
+			update.ShowAsButton = connection.ShowAsButton
+			changes = append(changes, "show_as_button")
+		}
+
+		if len(changes) == 0 {
+			p.record("connection", connection.Name, display.OrganizationProvisionUnchanged, "")
+			continue
+		}
+
+		if err := p.apply(func() error {
+			return p.cli.api.Organization.UpdateConnection(ctx, org.GetID(), id, update)
+		}); err != nil {
+			return fmt.Errorf("failed to update connection %q: %w", connection.Name, err)
+		}
+
+		p.record("connection", connection.Name, "update", strings.Join(changes, ", "))
+	}
+
+	return nil
+}
+
+// reconcileMembers adds the missing members and assigns their missing roles. It returns
+// the members of the organization, to avoid inviting users that are already members.
+func (p *orgProvisioner) reconcileMembers(
+	ctx context.Context,
+	org *management.Organization,
+	isNew bool,
+	members []orgManifestMember,
+) ([]management.OrganizationMember, error) {
+	var current []management.OrganizationMember
+	if !isNew {
+		var err error
+		if current, err = p.cli.getOrgMembers(ctx, org.GetID(), 0); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(members) == 0 {
+		return current, nil
+	}
+
+	isMember := make(map[string]bool, len(current))
+	for _, member := range current {
+		isMember[member.GetUserID()] = true
+	}
+
+	var added []string
+	for _, member := range members {
+		userID := p.userIDs[member.key()]
+		if !isMember[userID] && !slices.Contains(added, userID) {
+			added = append(added, userID)
+		}
+	}
+
+	if len(added) > 0 {
+		if err := p.apply(func() error {
+			return p.cli.api.Organization.AddMembers(ctx, org.GetID(), added)
+		}); err != nil {
+			return nil, fmt.Errorf("failed to add members to organization with name %q: %w", org.GetName(), err)
+		}
+	}
+
+	for _, member := range members {
+		userID := p.userIDs[member.key()]
+		if slices.Contains(added, userID) {
+			p.record("member", member.key(), "add", userID)
+		} else {
+			p.record("member", member.key(), display.OrganizationProvisionUnchanged, userID)
+		}
+
+		if len(member.Roles) == 0 {
+			continue
+		}
+
+		assigned := make(map[string]bool)
+		if isMember[userID] {
+			var roleList *management.OrganizationMemberRoleList
+			if err := ansi.Waiting(func() (err error) {
+				roleList, err = p.cli.api.Organization.MemberRoles(ctx, org.GetID(), userID)
+				return err
+			}); err != nil {
+				return nil, fmt.Errorf("failed to read roles of member %q: %w", member.key(), err)
+			}
+			for _, role := range roleList.Roles {
+				assigned[role.GetID()] = true
+			}
+		}
+
+		var roleIDs, roleNames []string
+		for _, name := range member.Roles {
+			id := p.roleIDs[name]
+			if !assigned[id] && !slices.Contains(roleIDs, id) {
+				roleIDs = append(roleIDs, id)
+				roleNames = append(roleNames, name)
+			}
+		}
+
+		if len(roleIDs) == 0 {
+			p.record("member roles", member.key(), display.OrganizationProvisionUnchanged, "")
+			continue
+		}
+
+		if err := p.apply(func() error {
+			return p.cli.api.Organization.AssignMemberRoles(ctx, org.GetID(), userID, roleIDs)
+		}); err != nil {
+			return nil, fmt.Errorf("failed to assign roles to member %q: %w", member.key(), err)
+		}
+
+		sort.Strings(roleNames)
+		p.record("member roles", member.key(), "assign", strings.Join(roleNames, ", "))
+	}
+
+	return current, nil
+}
+
+func (p *orgProvisioner) reconcileInvitations(
+	ctx context.Context,
+	org *management.Organization,
+	isNew bool,
+	members []management.OrganizationMember,
+	invitations []orgManifestInvitation,
+) error {
+	if len(invitations) == 0 {
+		return nil
+	}
+
+	invited := make(map[string]bool)
+	if !isNew {
+		current, err := p.cli.getOrgInvitations(ctx, org.GetID(), 0)
+		if err != nil {
+			return err
+		}
+		for _, invitation := range current {
+			invited[strings.ToLower(invitation.GetInvitee().GetEmail())] = true
+		}
+	}
+
+	isMember := make(map[string]bool, len(members))
+	for _, member := range members {
+		isMember[strings.ToLower(member.GetEmail())] = true
+	}
+
+	for _, invitation := range invitations {
+		email := strings.ToLower(invitation.Email)
+		switch {
+		case isMember[email]:
+			p.record("invitation", invitation.Email, display.OrganizationProvisionUnchanged, "already a member")
+			continue
+		case invited[email]:
+			p.record("invitation", invitation.Email, display.OrganizationProvisionUnchanged, "already invited")
+			continue
+		}
+
+		create := &management.OrganizationInvitation{
+			Inviter:             &management.OrganizationInvitationInviter{Name: auth0.String(invitation.InviterName)},
+			Invitee:             &management.OrganizationInvitationInvitee{Email: auth0.String(invitation.Email)},
+			ClientID:            auth0.String(invitation.ClientID),
+			SendInvitationEmail: invitation.SendEmail,
+		}
+		if invitation.Connection != "" {
+			create.ConnectionID = auth0.String(p.connectionIDs[invitation.Connection])
+		}
+		if invitation.TTLSec > 0 {
+			create.TTLSec = auth0.Int(invitation.TTLSec)
+		}
+		for _, name := range invitation.Roles {
+			create.Roles = append(create.Roles, p.roleIDs[name])
+		}
+
+		if err := p.apply(func() error {
+			return p.cli.api.Organization.CreateInvitation(ctx, org.GetID(), create)
+		}); err != nil {
+			return fmt.Errorf("failed to invite %q: %w", invitation.Email, err)
+		}
+
+		invited[email] = true
+		p.record("invitation", invitation.Email, "invite", create.GetID())
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const testOrgManifest = `
+name: acme
+display_name: Acme Inc.
+connections:
+  - name: acme-saml
+    assign_membership_on_login: true
+members:
+  - email: admin@acme.com
+    roles: [admin]
+invitations:
+  - email: jane@acme.com
+    inviter_name: Customer Success
+    client_id: client_1
+`
+
+func writeOrgManifest(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "customer.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+// expectOrgManifestReferences expects the lookups of the connections, roles and users of testOrgManifest.
+func expectOrgManifestReferences(ctrl *gomock.Controller) *auth0.API {
+	connectionAPI := mock.NewMockConnectionAPI(ctrl)
+	connectionAPI.EXPECT().
+		ReadByName(gomock.Any(), "acme-saml").
+		Return(&management.Connection{ID: auth0.String("con_1")}, nil)
+
+	roleAPI := mock.NewMockRoleAPI(ctrl)
+	roleAPI.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(&management.RoleList{Roles: []*management.Role{
+			{ID: auth0.String("rol_2"), Name: auth0.String("admin-readonly")},
+			{ID: auth0.String("rol_1"), Name: auth0.String("admin")},
+		}}, nil)
+
+	userAPI := mock.NewMockUserAPI(ctrl)
+	userAPI.EXPECT().
+		ListByEmail(gomock.Any(), "admin@acme.com").
+		Return([]*management.User{{ID: auth0.String("auth0|1")}}, nil)
+
+	return &auth0.API{Connection: connectionAPI, Role: roleAPI, User: userAPI}
+}
+
+func TestReadOrgManifest(t *testing.T) {
+	t.Run("it rejects unknown fields", func(t *testing.T) {
+		_, err := readOrgManifest(writeOrgManifest(t, "name: acme\nconnection: acme-saml\n"))
+		assert.ErrorContains(t, err, "field connection not found")
+	})
+
+	t.Run("it requires the name of the organization", func(t *testing.T) {
+		_, err := readOrgManifest(writeOrgManifest(t, "display_name: Acme Inc.\n"))
+		assert.ErrorContains(t, err, "the organization name is required")
+	})
+
+	t.Run("it requires a user_id or an email for members", func(t *testing.T) {
+		_, err := readOrgManifest(writeOrgManifest(t, "name: acme\nmembers:\n  - roles: [admin]\n"))
+		assert.ErrorContains(t, err, "member #1 has neither a user_id nor an email")
+	})
+}
+
+func TestOrgProvisionerProvision(t *testing.T) {
+	manifest, err := readOrgManifest(writeOrgManifest(t, testOrgManifest))
+	require.NoError(t, err)
+
+	t.Run("it creates the organization and everything it contains", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		api := expectOrgManifestReferences(ctrl)
+
+		organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+		organizationAPI.EXPECT().
+			ReadByName(gomock.Any(), "acme").
+			Return(nil, testManagementError{message: "Not Found", status: 404})
+		organizationAPI.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, org *management.Organization, _ ...management.RequestOption) error {
+				assert.Equal(t, "Acme Inc.", org.GetDisplayName())
+				org.ID = auth0.String("org_1")
+				return nil
+			})
+		organizationAPI.EXPECT().
+			AddConnection(gomock.Any(), "org_1", &management.OrganizationConnection{
+				ConnectionID:            auth0.String("con_1"),
+				AssignMembershipOnLogin: auth0.Bool(true),
+				ShowAsButton:            auth0.Bool(true),
+			}).
+			Return(nil)
+		organizationAPI.EXPECT().AddMembers(gomock.Any(), "org_1", []string{"auth0|1"}).Return(nil)
+		organizationAPI.EXPECT().AssignMemberRoles(gomock.Any(), "org_1", "auth0|1", []string{"rol_1"}).Return(nil)
+		organizationAPI.EXPECT().
+			CreateInvitation(gomock.Any(), "org_1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, invitation *management.OrganizationInvitation, _ ...management.RequestOption) error {
+				assert.Equal(t, "jane@acme.com", invitation.GetInvitee().GetEmail())
+				assert.Equal(t, "client_1", invitation.GetClientID())
+				return nil
+			})
+		api.Organization = organizationAPI
+
+		provisioner := &orgProvisioner{cli: &cli{api: api}}
+		require.NoError(t, provisioner.provision(context.Background(), manifest))
+
+		assert.Equal(t, []display.OrganizationProvisionAction{
+			{Resource: "organization", Target: "acme", Action: "create", Detail: "org_1"},
+			{Resource: "connection", Target: "acme-saml", Action: "enable"},
+			{Resource: "member", Target: "admin@acme.com", Action: "add", Detail: "auth0|1"},
+			{Resource: "member roles", Target: "admin@acme.com", Action: "assign", Detail: "admin"},
+			{Resource: "invitation", Target: "jane@acme.com", Action: "invite"},
+		}, provisioner.actions)
+	})
+
+	t.Run("it only plans the actions with dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		api := expectOrgManifestReferences(ctrl)
+
+		organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+		organizationAPI.EXPECT().
+			ReadByName(gomock.Any(), "acme").
+			Return(nil, testManagementError{message: "Not Found", status: 404})
+		api.Organization = organizationAPI
+
+		provisioner := &orgProvisioner{cli: &cli{api: api}, dryRun: true}
+		require.NoError(t, provisioner.provision(context.Background(), manifest))
+
+		var actions []string
+		for _, action := range provisioner.actions {
+			actions = append(actions, action.Action)
+		}
+		assert.Equal(t, []string{"create", "enable", "add", "assign", "invite"}, actions)
+	})
+
+	t.Run("it doesn't change an organization that matches the manifest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		api := expectOrgManifestReferences(ctrl)
+
+		organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+		organizationAPI.EXPECT().
+			ReadByName(gomock.Any(), "acme").
+			Return(&management.Organization{
+				ID:          auth0.String("org_1"),
+				Name:        auth0.String("acme"),
+				DisplayName: auth0.String("Acme Inc."),
+			}, nil)
+		organizationAPI.EXPECT().
+			Connections(gomock.Any(), "org_1", gomock.Any()).
+			Return(&management.OrganizationConnectionList{
+				OrganizationConnections: []*management.OrganizationConnection{
+					{ConnectionID: auth0.String("con_1"), AssignMembershipOnLogin: auth0.Bool(true), ShowAsButton: auth0.Bool(false)},
+				},
+			}, nil)
+		organizationAPI.EXPECT().
+			Members(gomock.Any(), "org_1", gomock.Any()).
+			Return(&management.OrganizationMemberList{
+				Members: []management.OrganizationMember{
+					{UserID: auth0.String("auth0|1"), Email: auth0.String("admin@acme.com")},
+					{UserID: auth0.String("auth0|2"), Email: auth0.String("Jane@acme.com")},
+				},
+			}, nil)
+		organizationAPI.EXPECT().
+			MemberRoles(gomock.Any(), "org_1", "auth0|1").
+			Return(&management.OrganizationMemberRoleList{
+				Roles: []management.OrganizationMemberRole{{ID: auth0.String("rol_1")}},
+			}, nil)
+		organizationAPI.EXPECT().
+			Invitations(gomock.Any(), "org_1", gomock.Any()).
+			Return(&management.OrganizationInvitationList{}, nil)
+		api.Organization = organizationAPI
+
+		provisioner := &orgProvisioner{cli: &cli{api: api}}
+		require.NoError(t, provisioner.provision(context.Background(), manifest))
+
+		for _, action := range provisioner.actions {
+			assert.Equal(t, display.OrganizationProvisionUnchanged, action.Action, action.Target)
+		}
+		assert.Len(t, provisioner.actions, 5)
+	})
+}
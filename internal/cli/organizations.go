@@ -183,9 +183,12 @@ func organizationsCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(updateOrganizationCmd(cli))
 	cmd.AddCommand(deleteOrganizationCmd(cli))
 	cmd.AddCommand(openOrganizationCmd(cli))
+	cmd.AddCommand(provisionOrganizationCmd(cli))
 	cmd.AddCommand(membersOrganizationCmd(cli))
 	cmd.AddCommand(rolesOrganizationCmd(cli))
 	cmd.AddCommand(invitationsOrganizationCmd(cli))
+	cmd.AddCommand(domainsOrganizationCmd(cli))
+	cmd.AddCommand(connectionsOrganizationCmd(cli))
 
 	return cmd
 }
@@ -592,6 +595,9 @@ func membersOrganizationCmd(cli *cli) *cobra.Command {
 
 	cmd.SetUsageTemplate(resourceUsageTemplate())
 	cmd.AddCommand(listMembersOrganizationCmd(cli))
+	cmd.AddCommand(addMembersOrganizationCmd(cli))
+	cmd.AddCommand(removeMembersOrganizationCmd(cli))
+	cmd.AddCommand(rolesMembersOrganizationCmd(cli))
 
 	return cmd
 }
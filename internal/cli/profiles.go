@@ -0,0 +1,348 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/config"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+// profileEnvVar selects the profile to use when --profile isn't passed.
+const profileEnvVar = "AUTH0_PROFILE"
+
+var (
+	profileName = Argument{
+		Name: "Profile",
+		Help: "Name of the profile.",
+	}
+
+	profileTenant = Flag{
+		Name:       "Tenant",
+		LongForm:   "tenant",
+		Help:       "Tenant of the profile. It must be one that you logged into.",
+		IsRequired: true,
+	}
+
+	profileApp = Flag{
+		Name:      "Default App",
+		LongForm:  "app",
+		ShortForm: "a",
+		Help:      "Client ID of the application to default to, e.g. in 'auth0 test login' and 'auth0 test token'.",
+	}
+
+	profileAudience = Flag{
+		Name:     "Default Audience",
+		LongForm: "audience",
+		Help:     "Identifier of the API to default to, e.g. in 'auth0 test login' and 'auth0 test token'.",
+	}
+
+	profileOutput = Flag{
+		Name:      "Output",
+		LongForm:  "output",
+		ShortForm: "o",
+		Help:      "Output format to default to. Options include: " + strings.Join(config.ProfileOutputs, ", ") + ".",
+	}
+
+	profileNoColor = Flag{
+		Name:     "No Color",
+		LongForm: "no-color",
+		Help:     "Disable colors when using the profile.",
+	}
+)
+
+func profilesCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "profiles",
+		Aliases: []string{"profile"},
+		Short:   "Manage named profiles",
+		Long: "Manage named profiles, which bundle a tenant with a default application, API audience and output format.\n\n" +
+			"Select a profile for a single command with `--profile` or the `" + profileEnvVar + "` environment variable, " +
+			"or for all commands with `auth0 profiles use`. Flags passed explicitly take precedence over the profile.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listProfilesCmd(cli))
+	cmd.AddCommand(createProfileCmd(cli))
+	cmd.AddCommand(useProfileCmd(cli))
+	cmd.AddCommand(deleteProfileCmd(cli))
+	return cmd
+}
+
+func listProfilesCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List your profiles",
+		Long:    "List your profiles. The profile in use is marked as active.",
+		Example: `  auth0 profiles list
+  auth0 profiles ls
+  auth0 profiles ls --json
+  auth0 profiles ls --json-compact
+  auth0 profiles ls --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profiles, err := cli.Config.ListAllProfiles()
+			if err != nil {
+				return fmt.Errorf("failed to load profiles: %w", err)
+			}
+
+			cli.renderer.ProfileList(profiles, cli.selectedProfileName())
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	return cmd
+}
+
+func createProfileCmd(cli *cli) *cobra.Command {
+	var inputs config.Profile
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Create a new profile",
+		Long: "Create a new profile.\n\n" +
+			"To create interactively, use `auth0 profiles create` with no arguments.\n\n" +
+			"To create non-interactively, supply the name of the profile and its tenant through the flags.",
+		Example: `  auth0 profiles create
+  auth0 profiles create <name>
+  auth0 profiles create <name> --tenant <tenant>
+  auth0 profiles create staging --tenant "staging.us.auth0.com" --app <client-id> --audience "https://api.example.com"
+  auth0 profiles create staging --tenant "staging.us.auth0.com" --output json --no-color
+  auth0 profiles create staging -a <client-id> -o csv --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := profileName.Ask(cmd, &inputs.Name); err != nil {
+					return err
+				}
+			} else {
+				inputs.Name = args[0]
+			}
+
+			if _, err := cli.Config.GetProfile(inputs.Name); err == nil {
+				return fmt.Errorf(
+					"a profile named %q already exists, run 'auth0 profiles delete %s' to delete it first",
+					inputs.Name,
+					inputs.Name,
+				)
+			}
+
+			if err := profileTenant.Pick(cmd, &inputs.Tenant, cli.tenantPickerOptions); err != nil {
+				return err
+			}
+
+			if err := cli.Config.AddProfile(inputs); err != nil {
+				return fmt.Errorf("failed to create profile %q: %w", inputs.Name, err)
+			}
+
+			cli.renderer.ProfileCreate(inputs)
+			return nil
+		},
+	}
+
+	profileTenant.RegisterString(cmd, &inputs.Tenant, "")
+	profileApp.RegisterString(cmd, &inputs.DefaultAppID, "")
+	profileAudience.RegisterString(cmd, &inputs.DefaultAudience, "")
+	profileOutput.RegisterString(cmd, &inputs.Output, "")
+	profileNoColor.RegisterBool(cmd, &inputs.NoColor, false)
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func useProfileCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Set the default profile",
+		Long: "Set the profile that commands use by default.\n\n" +
+			"The `--profile` flag and the `" + profileEnvVar + "` environment variable take precedence over it.",
+		Example: `  auth0 profiles use
+  auth0 profiles use <name>
+  auth0 profiles use staging`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, err := selectProfileFromConfig(cli, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			if err := cli.Config.SetDefaultProfile(name); err != nil {
+				return fmt.Errorf("failed to set the default profile: %w", err)
+			}
+
+			cli.renderer.Infof("Default profile switched to: %s", name)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func deleteProfileCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Delete a profile",
+		Long: "Delete a profile. The tenant of the profile stays logged in.\n\n" +
+			"To delete interactively, use `auth0 profiles delete` with no arguments.\n\n" +
+			"To delete non-interactively, supply the name of the profile and the `--force` flag to skip confirmation.",
+		Example: `  auth0 profiles delete
+  auth0 profiles rm
+  auth0 profiles delete <name>
+  auth0 profiles delete <name> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, err := selectProfileFromConfig(cli, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			if err := cli.Config.RemoveProfile(name); err != nil {
+				return fmt.Errorf("failed to delete profile %q: %w", name, err)
+			}
+
+			cli.renderer.Infof("Successfully deleted profile: %s", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func selectProfileFromConfig(cli *cli, cmd *cobra.Command, args []string) (string, error) {
+	if len(args) > 0 {
+		profile, err := cli.Config.GetProfile(args[0])
+		if err != nil {
+			return "", err
+		}
+
+		return profile.Name, nil
+	}
+
+	var selectedProfile string
+	err := profileName.Pick(cmd, &selectedProfile, cli.profilePickerOptions)
+	return selectedProfile, err
+}
+
+func (c *cli) profilePickerOptions(_ context.Context) (pickerOptions, error) {
+	profiles, err := c.Config.ListAllProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load profiles: %w", err)
+	}
+
+	if len(profiles) == 0 {
+		return nil, errors.New("there are no profiles to choose from. Run 'auth0 profiles create' to create one")
+	}
+
+	var priorityOpts, opts pickerOptions
+	for _, profile := range profiles {
+		opt := pickerOption{value: profile.Name, label: fmt.Sprintf("%s (%s)", profile.Name, profile.Tenant)}
+
+		if profile.Name == c.Config.DefaultProfile {
+			priorityOpts = append(priorityOpts, opt)
+		} else {
+			opts = append(opts, opt)
+		}
+	}
+
+	return append(priorityOpts, opts...), nil
+}
+
+// selectedProfileName returns the name of the profile to use: the one passed
+// with --profile, then the one in AUTH0_PROFILE, then the default one.
+func (c *cli) selectedProfileName() string {
+	if c.profile != "" {
+		return c.profile
+	}
+
+	if name := strings.TrimSpace(os.Getenv(profileEnvVar)); name != "" {
+		return name
+	}
+
+	_ = c.Config.Initialize()
+
+	return c.Config.DefaultProfile
+}
+
+// applyProfile defaults the tenant, output format and colors to the ones of
+// the profile in use, unless they were set explicitly through the flags. A
+// missing profile is only an error when passed with --profile: a stale one from
+// AUTH0_PROFILE or the config falls back to the default tenant with a warning.
+func (c *cli) applyProfile(cmd *cobra.Command) error {
+	name := c.selectedProfileName()
+	if name == "" {
+		return nil
+	}
+
+	profile, err := c.Config.GetProfile(name)
+	if err != nil {
+		if c.profile == "" && errors.Is(err, config.ErrProfileNotFound) {
+			c.renderer.Warnf("Profile %q no longer exists, using the default tenant instead. "+
+				"Run 'auth0 profiles use' to pick another default profile or unset %s.", name, profileEnvVar)
+			return nil
+		}
+		return err
+	}
+
+	if !flagChanged(cmd, "tenant") {
+		c.tenant = profile.Tenant
+	} else if c.tenant != profile.Tenant {
+		// The app and the audience belong to the tenant of the profile.
+		profile.DefaultAppID = ""
+		profile.DefaultAudience = ""
+	}
+
+	if !anyFlagChanged(cmd, "json", "json-compact", "csv") {
+		switch profile.Output {
+		case "json":
+			c.json = true
+		case "json-compact":
+			c.jsonCompact = true
+		case "csv":
+			c.csv = true
+		}
+	}
+
+	if profile.NoColor && !flagChanged(cmd, "no-color") {
+		c.noColor = true
+	}
+
+	c.activeProfile = profile
+
+	return nil
+}
+
+// applyProfileAudience defaults the audience flag of the
+// command to the default audience of the profile in use.
+func (c *cli) applyProfileAudience(cmd *cobra.Command, f *Flag) error {
+	if c.activeProfile.DefaultAudience == "" || f.IsSet(cmd) {
+		return nil
+	}
+
+	return cmd.Flags().Set(f.LongForm, c.activeProfile.DefaultAudience)
+}
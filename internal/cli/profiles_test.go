@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const profilesTestConfig = `{
+	"install_id": "3998b053-dd7f-4bfe-bb10-c4f3a96a0180",
+	"default_tenant": "auth0-cli.eu.auth0.com",
+	"default_profile": "staging",
+	"tenants": {
+		"auth0-cli.eu.auth0.com": {
+			"name": "auth0-cli",
+			"domain": "auth0-cli.eu.auth0.com",
+			"access_token": "eyfSaswe",
+			"expires_at": "2023-04-18T11:18:07.998809Z",
+			"client_id": "secret"
+		},
+		"auth0-cli-staging.eu.auth0.com": {
+			"name": "auth0-cli-staging",
+			"domain": "auth0-cli-staging.eu.auth0.com",
+			"access_token": "eyfSaswe",
+			"expires_at": "2023-04-18T11:18:07.998809Z",
+			"client_id": "secret"
+		}
+	},
+	"profiles": {
+		"staging": {
+			"name": "staging",
+			"tenant": "auth0-cli-staging.eu.auth0.com",
+			"default_app_id": "staging-client-id",
+			"default_audience": "https://staging.example.com",
+			"output": "json",
+			"no_color": true
+		},
+		"prod": {
+			"name": "prod",
+			"tenant": "auth0-cli.eu.auth0.com",
+			"output": "csv"
+		}
+	}
+}`
+
+// setupProfilesTestConfig writes the config to a temporary home directory.
+func setupProfilesTestConfig(t *testing.T) {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(profileEnvVar, "")
+
+	dir := filepath.Join(home, ".config", "auth0")
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.json"), []byte(profilesTestConfig), 0600))
+}
+
+func newProfilesTestCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().StringVar(&cli.profile, "profile", "", "")
+	cmd.Flags().StringVar(&cli.tenant, "tenant", "", "")
+	cmd.Flags().BoolVar(&cli.json, "json", false, "")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "")
+	cmd.Flags().BoolVar(&cli.noColor, "no-color", false, "")
+	testAudience.RegisterString(cmd, new(string), "")
+	return cmd
+}
+
+func TestApplyProfile(t *testing.T) {
+	t.Run("it applies the default profile", func(t *testing.T) {
+		setupProfilesTestConfig(t)
+
+		cli := &cli{}
+		cmd := newProfilesTestCmd(cli)
+		require.NoError(t, cmd.ParseFlags(nil))
+
+		require.NoError(t, cli.applyProfile(cmd))
+		assert.Equal(t, "auth0-cli-staging.eu.auth0.com", cli.tenant)
+		assert.True(t, cli.json)
+		assert.True(t, cli.noColor)
+		assert.Equal(t, "staging-client-id", cli.activeProfile.DefaultAppID)
+
+		require.NoError(t, cli.applyProfileAudience(cmd, &testAudience))
+		assert.Equal(t, "https://staging.example.com", cmd.Flags().Lookup("identifier").Value.String())
+	})
+
+	t.Run("it applies the profile from the env var", func(t *testing.T) {
+		setupProfilesTestConfig(t)
+		t.Setenv(profileEnvVar, "prod")
+
+		cli := &cli{}
+		cmd := newProfilesTestCmd(cli)
+		require.NoError(t, cmd.ParseFlags(nil))
+
+		require.NoError(t, cli.applyProfile(cmd))
+		assert.Equal(t, "auth0-cli.eu.auth0.com", cli.tenant)
+		assert.True(t, cli.csv)
+		assert.False(t, cli.json)
+		assert.False(t, cli.noColor)
+	})
+
+	t.Run("it prefers the flags over the profile", func(t *testing.T) {
+		setupProfilesTestConfig(t)
+		t.Setenv(profileEnvVar, "prod")
+
+		cli := &cli{}
+		cmd := newProfilesTestCmd(cli)
+		require.NoError(t, cmd.ParseFlags([]string{
+			"--profile", "staging",
+			"--tenant", "auth0-cli.eu.auth0.com",
+			"--json-compact",
+			"--identifier", "https://api.example.com",
+		}))
+
+		require.NoError(t, cli.applyProfile(cmd))
+		assert.Equal(t, "auth0-cli.eu.auth0.com", cli.tenant)
+		assert.True(t, cli.jsonCompact)
+		assert.False(t, cli.json)
+		assert.True(t, cli.noColor)
+
+		// The app of the profile belongs to a different tenant.
+		assert.Empty(t, cli.activeProfile.DefaultAppID)
+
+		require.NoError(t, cli.applyProfileAudience(cmd, &testAudience))
+		assert.Equal(t, "https://api.example.com", cmd.Flags().Lookup("identifier").Value.String())
+	})
+
+	t.Run("it fails when the profile doesn't exist", func(t *testing.T) {
+		setupProfilesTestConfig(t)
+
+		cli := &cli{}
+		cmd := newProfilesTestCmd(cli)
+		require.NoError(t, cmd.ParseFlags([]string{"--profile", "dev"}))
+
+		err := cli.applyProfile(cmd)
+		assert.ErrorContains(t, err, "profile not found: dev")
+	})
+
+	t.Run("it falls back to the default tenant when the profile from the env var doesn't exist", func(t *testing.T) {
+		setupProfilesTestConfig(t)
+		t.Setenv(profileEnvVar, "dev")
+
+		messages := &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: messages, ResultWriter: io.Discard}}
+		cmd := newProfilesTestCmd(cli)
+		require.NoError(t, cmd.ParseFlags(nil))
+
+		require.NoError(t, cli.applyProfile(cmd))
+		assert.Empty(t, cli.tenant)
+		assert.False(t, cli.json)
+		assert.Contains(t, messages.String(), `Profile "dev" no longer exists, using the default tenant instead.`)
+	})
+}
+
+func TestProfilesListCmd(t *testing.T) {
+	setupProfilesTestConfig(t)
+
+	stdout := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{
+			MessageWriter: io.Discard,
+			ResultWriter:  stdout,
+			Format:        display.OutputFormatJSON,
+		},
+		profile: "prod",
+	}
+
+	cmd := listProfilesCmd(cli)
+	cmd.SetArgs([]string{})
+	require.NoError(t, cmd.Execute())
+
+	assert.JSONEq(t, `[
+		{"name": "prod", "tenant": "auth0-cli.eu.auth0.com", "output": "csv", "active": true},
+		{
+			"name": "staging",
+			"tenant": "auth0-cli-staging.eu.auth0.com",
+			"default_app_id": "staging-client-id",
+			"default_audience": "https://staging.example.com",
+			"output": "json",
+			"no_color": true,
+			"active": false
+		}
+	]`, stdout.String())
+}
@@ -94,6 +94,7 @@ func Execute() {
 
 	cancelCtx := contextWithCancel()
 	err := rootCmd.ExecuteContext(cancelCtx)
+	err = errors.Join(err, cli.closeTransport())
 	trackCommandOutcome(cli, err)
 
 	timeoutCtx, cancel := context.WithTimeout(cancelCtx, 3*time.Second)
@@ -119,6 +120,18 @@ func buildRootCmd(cli *cli) *cobra.Command {
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			cli.executedCommandPath = cmd.CommandPath()
 
+			// Loading the config selects the credential store, which
+			// must happen before any secret is stored or retrieved.
+			if err := cli.Config.Initialize(); errors.Is(err, config.ErrCredentialStore) {
+				return err
+			}
+
+			if !strings.HasPrefix(cmd.CommandPath(), "auth0 profiles") {
+				if err := cli.applyProfile(cmd); err != nil {
+					return err
+				}
+			}
+
 			applyAgentModeDefaults(cli, cmd)
 
 			ansi.Initialize(cli.noColor)
@@ -130,6 +143,14 @@ func buildRootCmd(cli *cli) *cobra.Command {
 				cli.renderer.Infof("Agent mode on: JSON output, prompts and colors off. Disable with --agent-mode=false.")
 			}
 
+			if cli.maxRetries < 0 {
+				return fmt.Errorf("invalid --max-retries value: %d, it must be 0 or more", cli.maxRetries)
+			}
+
+			if cli.record != "" && cli.replay != "" {
+				return errors.New("--record and --replay can't be used together")
+			}
+
 			if !commandRequiresAuthentication(cmd.CommandPath()) {
 				return nil
 			}
@@ -154,6 +175,11 @@ func commandRequiresAuthentication(invokedCommandName string) bool {
 		"auth0 logout",
 		"auth0 tenants use",
 		"auth0 tenants list",
+		"auth0 tenants diff",
+		"auth0 profiles list",
+		"auth0 profiles create",
+		"auth0 profiles use",
+		"auth0 profiles delete",
 		"auth0 agent skills install",
 	}
 
@@ -239,6 +265,9 @@ func addPersistentFlags(rootCmd *cobra.Command, cli *cli) {
 	rootCmd.PersistentFlags().StringVar(&cli.tenant,
 		"tenant", cli.Config.DefaultTenant, "Specific tenant to use.")
 
+	rootCmd.PersistentFlags().StringVar(&cli.profile,
+		"profile", "", "Named profile to use, instead of the one set with 'auth0 profiles use'. Defaults to the "+profileEnvVar+" environment variable.")
+
 	rootCmd.PersistentFlags().BoolVar(&cli.debug,
 		"debug", false, "Enable debug mode.")
 
@@ -248,6 +277,15 @@ func addPersistentFlags(rootCmd *cobra.Command, cli *cli) {
 	rootCmd.PersistentFlags().BoolVar(&cli.noColor,
 		"no-color", false, "Disable colors.")
 
+	rootCmd.PersistentFlags().IntVar(&cli.maxRetries,
+		"max-retries", defaultMaxRetries, "Maximum number of times to retry a Management API request that was rate limited or failed with a server error.")
+
+	rootCmd.PersistentFlags().StringVar(&cli.record,
+		"record", "", "Record every Management API request and response to a HAR file, with credentials and secrets redacted.")
+
+	rootCmd.PersistentFlags().StringVar(&cli.replay,
+		"replay", "", "Serve the Management API responses from a HAR file recorded with --record, instead of sending the requests.")
+
 	rootCmd.PersistentFlags().BoolVar(&cli.agentMode,
 		"agent-mode", false,
 		"Output JSON, disable prompts and colors. Auto-enabled for AI agents; set AUTH0_AGENT_MODE=false to disable.")
@@ -260,15 +298,21 @@ func addSubCommands(rootCmd *cobra.Command, cli *cli) {
 	rootCmd.AddCommand(loginCmd(cli))
 	rootCmd.AddCommand(logoutCmd(cli))
 	rootCmd.AddCommand(tenantsCmd(cli))
+	rootCmd.AddCommand(profilesCmd(cli))
 	rootCmd.AddCommand(appsCmd(cli))
 	rootCmd.AddCommand(aculCmd(cli))
 	rootCmd.AddCommand(usersCmd(cli))
 	rootCmd.AddCommand(rulesCmd(cli))
 	rootCmd.AddCommand(actionsCmd(cli))
+	rootCmd.AddCommand(formsCmd(cli))
+	rootCmd.AddCommand(flowsCmd(cli))
 	rootCmd.AddCommand(apisCmd(cli))
 	rootCmd.AddCommand(clientGrantsCmd(cli))
+	rootCmd.AddCommand(connectionsCmd(cli))
 	rootCmd.AddCommand(rolesCmd(cli))
 	rootCmd.AddCommand(organizationsCmd(cli))
+	rootCmd.AddCommand(selfServiceProfilesCmd(cli))
+	rootCmd.AddCommand(userAttributeProfilesCmd(cli))
 	rootCmd.AddCommand(universalLoginCmd(cli))
 	rootCmd.AddCommand(phoneCmd(cli))
 	rootCmd.AddCommand(emailCmd(cli))
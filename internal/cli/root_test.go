@@ -44,6 +44,11 @@ func TestCommandRequiresAuthentication(t *testing.T) {
 		{"auth0 logout", false},
 		{"auth0 tenants use", false},
 		{"auth0 tenants list", false},
+		{"auth0 tenants diff", false},
+		{"auth0 profiles list", false},
+		{"auth0 profiles create", false},
+		{"auth0 profiles use", false},
+		{"auth0 profiles delete", false},
 	}
 
 	for index, testCase := range testCases {
@@ -0,0 +1,714 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var selfServiceProfileStrategies = []string{"oidc", "samlp", "waad", "google-apps", "adfs", "okta", "keycloak-samlp", "pingfederate"}
+
+var (
+	selfServiceProfileID = Argument{
+		Name: "Profile ID",
+		Help: "Id of the self-service profile.",
+	}
+
+	selfServiceProfileName = Flag{
+		Name:       "Name",
+		LongForm:   "name",
+		ShortForm:  "n",
+		Help:       "Name of the self-service profile.",
+		IsRequired: true,
+	}
+
+	selfServiceProfileDescription = Flag{
+		Name:      "Description",
+		LongForm:  "description",
+		ShortForm: "d",
+		Help:      "Description of the self-service profile.",
+	}
+
+	selfServiceProfileAllowedStrategies = Flag{
+		Name:      "Allowed Strategies",
+		LongForm:  "allowed-strategies",
+		ShortForm: "s",
+		Help: "Comma-separated list of the identity provider strategies shown to users during the self-service SSO flow. " +
+			"Options include: " + strings.Join(selfServiceProfileStrategies, ", ") + ".",
+	}
+
+	selfServiceProfileUserAttributes = Flag{
+		Name:     "User Attributes",
+		LongForm: "user-attributes",
+		Help: "User attributes to be mapped during the self-service SSO flow, formatted as JSON. " +
+			`Eg: [{"name":"email","description":"Email of the user","is_optional":false}]`,
+	}
+
+	selfServiceProfileUserAttributeProfileID = Flag{
+		Name:     "User Attribute Profile ID",
+		LongForm: "user-attribute-profile-id",
+		Help:     "ID of the user attribute profile to use instead of the user attributes.",
+	}
+
+	selfServiceProfileLogoURL = Flag{
+		Name:      "Logo URL",
+		LongForm:  "logo-url",
+		ShortForm: "l",
+		Help:      "URL of the logo displayed during the self-service SSO flow. Must use HTTPS.",
+	}
+
+	selfServiceProfilePrimaryColor = Flag{
+		Name:      "Primary Color",
+		LongForm:  "primary-color",
+		ShortForm: "c",
+		Help:      "Primary color of the self-service SSO flow, in hexadecimal.",
+	}
+
+	selfServiceProfileNumber = Flag{
+		Name:      "Number",
+		LongForm:  "number",
+		ShortForm: "n",
+		Help:      "Number of self-service profiles to retrieve. Minimum 1, maximum 1000.",
+	}
+
+	selfServiceTicketOrganizations = Flag{
+		Name:     "Organization IDs",
+		LongForm: "org-id",
+		Help:     "Comma-separated list of IDs of the organizations the connection is enabled for.",
+	}
+
+	selfServiceTicketConnectionID = Flag{
+		Name:     "Connection ID",
+		LongForm: "connection-id",
+		Help:     "ID of an existing connection to edit during the self-service SSO flow, instead of creating one.",
+	}
+
+	selfServiceTicketConnectionName = Flag{
+		Name:     "Connection Name",
+		LongForm: "connection-name",
+		Help:     "Name of the connection created during the self-service SSO flow.",
+	}
+
+	selfServiceTicketConnectionDisplayName = Flag{
+		Name:     "Connection Display Name",
+		LongForm: "connection-display-name",
+		Help:     "Display name of the connection created during the self-service SSO flow.",
+	}
+
+	selfServiceTicketDomainAliases = Flag{
+		Name:     "Domain Aliases",
+		LongForm: "domain-aliases",
+		Help:     "Comma-separated list of the email domains of the users of the created connection, used for home realm discovery.",
+	}
+
+	selfServiceTicketDomainVerification = Flag{
+		Name:     "Domain Verification",
+		LongForm: "domain-verification",
+		Help:     "Whether the customer must verify the domain aliases. Options include: none, optional and required.",
+	}
+
+	selfServiceTicketEnabledClients = Flag{
+		Name:     "Enabled Clients",
+		LongForm: "enabled-clients",
+		Help:     "Comma-separated list of IDs of the applications the connection is enabled for.",
+	}
+
+	selfServiceTicketAssignMembership = Flag{
+		Name:     "Assign Membership On Login",
+		LongForm: "assign-membership-on-login",
+		Help:     "Whether users logging in with the connection are automatically granted membership in the organizations.",
+	}
+
+	selfServiceTicketShowAsButton = Flag{
+		Name:     "Show As Button",
+		LongForm: "show-as-button",
+		Help:     "Whether the connection is displayed on the login prompt of the organizations.",
+	}
+
+	selfServiceTicketTTL = Flag{
+		Name:      "TTL Seconds",
+		LongForm:  "ttl-sec",
+		ShortForm: "t",
+		Help:      "Number of seconds for which the ticket is valid before expiration. Defaults to 432000 (5 days).",
+	}
+)
+
+func selfServiceProfilesCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "self-service-profiles",
+		Aliases: []string{"ssp"},
+		Short:   "Manage resources for self-service SSO profiles",
+		Long: "Self-service SSO profiles let your customers configure their own enterprise SSO connection, " +
+			"through a setup ticket URL that you share with them. To learn more, read " +
+			"[Self-Service Single Sign-On](https://auth0.com/docs/authenticate/enterprise-connections/self-service-SSO).",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listSelfServiceProfilesCmd(cli))
+	cmd.AddCommand(showSelfServiceProfileCmd(cli))
+	cmd.AddCommand(createSelfServiceProfileCmd(cli))
+	cmd.AddCommand(updateSelfServiceProfileCmd(cli))
+	cmd.AddCommand(deleteSelfServiceProfileCmd(cli))
+	cmd.AddCommand(createTicketSelfServiceProfileCmd(cli))
+
+	return cmd
+}
+
+func listSelfServiceProfilesCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Number int
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List your self-service profiles",
+		Long:    "List your existing self-service SSO profiles. To create one, run: `auth0 self-service-profiles create`.",
+		Example: `  auth0 self-service-profiles list
+  auth0 self-service-profiles ls
+  auth0 self-service-profiles ls --number 100
+  auth0 self-service-profiles ls -n 100 --json
+  auth0 self-service-profiles ls -n 100 --json-compact
+  auth0 self-service-profiles ls --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Number < 1 || inputs.Number > 1000 {
+				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
+			}
+
+			list, err := getWithPagination(
+				inputs.Number,
+				func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+					profileList, err := cli.api.SelfServiceProfile.List(cmd.Context(), opts...)
+					if err != nil {
+						return nil, false, err
+					}
+
+					for _, profile := range profileList.SelfServiceProfile {
+						result = append(result, profile)
+					}
+
+					return result, profileList.HasNext(), nil
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to list self-service profiles: %w", err)
+			}
+
+			var profiles []*management.SelfServiceProfile
+			for _, item := range list {
+				profiles = append(profiles, item.(*management.SelfServiceProfile))
+			}
+
+			cli.renderer.SelfServiceProfileList(profiles)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	selfServiceProfileNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+
+	return cmd
+}
+
+func showSelfServiceProfileCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show a self-service profile",
+		Long:  "Display information about a self-service SSO profile.",
+		Example: `  auth0 self-service-profiles show
+  auth0 self-service-profiles show <profile-id>
+  auth0 self-service-profiles show <profile-id> --json
+  auth0 self-service-profiles show <profile-id> --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := selfServiceProfileID.Pick(cmd, &inputs.ID, cli.selfServiceProfilePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var profile *management.SelfServiceProfile
+			if err := ansi.Waiting(func() (err error) {
+				profile, err = cli.api.SelfServiceProfile.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read self-service profile with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.SelfServiceProfileShow(profile)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func createSelfServiceProfileCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Name                   string
+		Description            string
+		AllowedStrategies      []string
+		UserAttributes         string
+		UserAttributeProfileID string
+		LogoURL                string
+		PrimaryColor           string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Args:  cobra.NoArgs,
+		Short: "Create a new self-service profile",
+		Long: "Create a new self-service SSO profile.\n\n" +
+			"To create interactively, use `auth0 self-service-profiles create` with no flags.\n\n" +
+			"To create non-interactively, supply the name and other information through the flags.",
+		Example: `  auth0 self-service-profiles create
+  auth0 self-service-profiles create --name "Enterprise SSO"
+  auth0 self-service-profiles create -n "Enterprise SSO" -d "SSO for enterprise customers" -s oidc,samlp,okta
+  auth0 self-service-profiles create -n "Enterprise SSO" --user-attributes '[{"name":"email","description":"Email of the user","is_optional":false}]'
+  auth0 self-service-profiles create -n "Enterprise SSO" -l "https://example.com/logo.png" -c "#635DFF" --json
+  auth0 self-service-profiles create -n "Enterprise SSO" -l "https://example.com/logo.png" -c "#635DFF" --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := selfServiceProfileName.Ask(cmd, &inputs.Name, nil); err != nil {
+				return err
+			}
+
+			if err := selfServiceProfileDescription.Ask(cmd, &inputs.Description, nil); err != nil {
+				return err
+			}
+
+			if err := selfServiceProfileAllowedStrategies.AskMany(cmd, &inputs.AllowedStrategies, nil); err != nil {
+				return err
+			}
+
+			profile := &management.SelfServiceProfile{
+				Name: &inputs.Name,
+			}
+			if inputs.Description != "" {
+				profile.Description = &inputs.Description
+			}
+
+			if err := applySelfServiceProfileInputs(
+				profile,
+				inputs.AllowedStrategies,
+				inputs.UserAttributes,
+				inputs.UserAttributeProfileID,
+				inputs.LogoURL,
+				inputs.PrimaryColor,
+			); err != nil {
+				return err
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.SelfServiceProfile.Create(cmd.Context(), profile)
+			}); err != nil {
+				return fmt.Errorf("failed to create self-service profile: %w", err)
+			}
+
+			cli.renderer.SelfServiceProfileCreate(profile)
+			return nil
+		},
+	}
+
+	selfServiceProfileName.RegisterString(cmd, &inputs.Name, "")
+	selfServiceProfileDescription.RegisterString(cmd, &inputs.Description, "")
+	selfServiceProfileAllowedStrategies.RegisterStringSlice(cmd, &inputs.AllowedStrategies, nil)
+	selfServiceProfileUserAttributes.RegisterString(cmd, &inputs.UserAttributes, "")
+	selfServiceProfileUserAttributeProfileID.RegisterString(cmd, &inputs.UserAttributeProfileID, "")
+	selfServiceProfileLogoURL.RegisterString(cmd, &inputs.LogoURL, "")
+	selfServiceProfilePrimaryColor.RegisterString(cmd, &inputs.PrimaryColor, "")
+	cmd.MarkFlagsMutuallyExclusive("user-attributes", "user-attribute-profile-id")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func updateSelfServiceProfileCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID                     string
+		Name                   string
+		Description            string
+		AllowedStrategies      []string
+		UserAttributes         string
+		UserAttributeProfileID string
+		LogoURL                string
+		PrimaryColor           string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Update a self-service profile",
+		Long: "Update a self-service SSO profile.\n\n" +
+			"To update interactively, use `auth0 self-service-profiles update` with no arguments.\n\n" +
+			"To update non-interactively, supply the profile id and the information to change through the flags.",
+		Example: `  auth0 self-service-profiles update
+  auth0 self-service-profiles update <profile-id> --name "Enterprise SSO"
+  auth0 self-service-profiles update <profile-id> -d "SSO for enterprise customers" -s oidc,samlp
+  auth0 self-service-profiles update <profile-id> --user-attribute-profile-id <user-attribute-profile-id>
+  auth0 self-service-profiles update <profile-id> -l "https://example.com/logo.png" -c "#635DFF" --json
+  auth0 self-service-profiles update <profile-id> -l "https://example.com/logo.png" -c "#635DFF" --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := selfServiceProfileID.Pick(cmd, &inputs.ID, cli.selfServiceProfilePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var current *management.SelfServiceProfile
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.api.SelfServiceProfile.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read self-service profile with ID %q: %w", inputs.ID, err)
+			}
+
+			if err := selfServiceProfileName.AskU(cmd, &inputs.Name, current.Name); err != nil {
+				return err
+			}
+
+			if err := selfServiceProfileDescription.AskU(cmd, &inputs.Description, current.Description); err != nil {
+				return err
+			}
+
+			update := &management.SelfServiceProfile{}
+			if inputs.Name != "" {
+				update.Name = &inputs.Name
+			}
+			if inputs.Description != "" {
+				update.Description = &inputs.Description
+			}
+
+			if err := applySelfServiceProfileInputs(
+				update,
+				inputs.AllowedStrategies,
+				inputs.UserAttributes,
+				inputs.UserAttributeProfileID,
+				inputs.LogoURL,
+				inputs.PrimaryColor,
+			); err != nil {
+				return err
+			}
+
+			mergeSelfServiceProfileBranding(update.Branding, current.Branding)
+
+			var updated *management.SelfServiceProfile
+			if err := ansi.Waiting(func() (err error) {
+				if err = cli.api.SelfServiceProfile.Update(cmd.Context(), inputs.ID, update); err != nil {
+					return err
+				}
+				updated, err = cli.api.SelfServiceProfile.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to update self-service profile with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.SelfServiceProfileUpdate(updated)
+			return nil
+		},
+	}
+
+	selfServiceProfileName.RegisterStringU(cmd, &inputs.Name, "")
+	selfServiceProfileDescription.RegisterStringU(cmd, &inputs.Description, "")
+	selfServiceProfileAllowedStrategies.RegisterStringSliceU(cmd, &inputs.AllowedStrategies, nil)
+	selfServiceProfileUserAttributes.RegisterStringU(cmd, &inputs.UserAttributes, "")
+	selfServiceProfileUserAttributeProfileID.RegisterStringU(cmd, &inputs.UserAttributeProfileID, "")
+	selfServiceProfileLogoURL.RegisterStringU(cmd, &inputs.LogoURL, "")
+	selfServiceProfilePrimaryColor.RegisterStringU(cmd, &inputs.PrimaryColor, "")
+	cmd.MarkFlagsMutuallyExclusive("user-attributes", "user-attribute-profile-id")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func deleteSelfServiceProfileCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a self-service profile",
+		Long: "Delete a self-service SSO profile.\n\n" +
+			"To delete interactively, use `auth0 self-service-profiles delete` with no arguments.\n\n" +
+			"To delete non-interactively, supply the profile id and the `--force` flag to skip confirmation.",
+		Example: `  auth0 self-service-profiles delete
+  auth0 self-service-profiles rm
+  auth0 self-service-profiles delete <profile-id>
+  auth0 self-service-profiles delete <profile-id> --force
+  auth0 self-service-profiles delete <profile-id> <profile-id2> <profile-idn>
+  auth0 self-service-profiles delete <profile-id> <profile-id2> <profile-idn> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var ids []string
+			if len(args) == 0 {
+				if err := selfServiceProfileID.PickMany(cmd, &ids, cli.selfServiceProfilePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				ids = args
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			return ansi.ProgressBar("Deleting self-service profile(s)", ids, func(_ int, id string) error {
+				if id != "" {
+					if err := cli.api.SelfServiceProfile.Delete(cmd.Context(), id); err != nil {
+						return fmt.Errorf("failed to delete self-service profile with ID %q: %w", id, err)
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func createTicketSelfServiceProfileCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID                      string
+		OrganizationIDs         []string
+		ConnectionID            string
+		ConnectionName          string
+		ConnectionDisplayName   string
+		DomainAliases           []string
+		DomainVerification      string
+		EnabledClients          []string
+		AssignMembershipOnLogin bool
+		ShowAsButton            bool
+		TTLSec                  int
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create-ticket",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Create a self-service SSO setup ticket",
+		Long: "Create a ticket URL to share with a customer, for them to set up their enterprise SSO connection " +
+			"through the self-service SSO flow of a profile.\n\n" +
+			"The connection is created with the given name, or an existing connection is edited with `--connection-id`. " +
+			"It's enabled for the given organizations and applications once set up.",
+		Example: `  auth0 self-service-profiles create-ticket <profile-id> --org-id <org-id> --connection-name acme-sso
+  auth0 self-service-profiles create-ticket <profile-id> --org-id <org-id> --connection-name acme-sso --connection-display-name "Acme SSO"
+  auth0 self-service-profiles create-ticket <profile-id> --org-id <org-id> --connection-name acme-sso --domain-aliases acme.com --domain-verification required
+  auth0 self-service-profiles create-ticket <profile-id> --org-id <org-id> --connection-name acme-sso --assign-membership-on-login --show-as-button
+  auth0 self-service-profiles create-ticket <profile-id> --connection-id <connection-id> --enabled-clients <client-id> --ttl-sec 86400
+  auth0 self-service-profiles create-ticket <profile-id> --org-id <org-id> --connection-name acme-sso --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := selfServiceProfileID.Pick(cmd, &inputs.ID, cli.selfServiceProfilePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			if inputs.ConnectionID == "" {
+				if err := selfServiceTicketConnectionName.Ask(cmd, &inputs.ConnectionName, nil); err != nil {
+					return err
+				}
+			}
+
+			if inputs.ConnectionID == "" && inputs.ConnectionName == "" {
+				return errors.New("either a connection id or a connection name is required")
+			}
+
+			if inputs.DomainVerification != "" && !slices.Contains([]string{"none", "optional", "required"}, inputs.DomainVerification) {
+				return fmt.Errorf("invalid domain verification %q, please use one of: none, optional, required", inputs.DomainVerification)
+			}
+
+			ticket := &management.SelfServiceProfileTicket{
+				TTLSec: inputs.TTLSec,
+			}
+
+			if inputs.ConnectionID != "" {
+				ticket.ConnectionID = &inputs.ConnectionID
+			} else {
+				ticket.ConnectionConfig = &management.SelfServiceProfileTicketConnectionConfig{
+					Name: &inputs.ConnectionName,
+				}
+				if inputs.ConnectionDisplayName != "" {
+					ticket.ConnectionConfig.DisplayName = &inputs.ConnectionDisplayName
+				}
+				if len(inputs.DomainAliases) > 0 {
+					ticket.ConnectionConfig.Options = &management.SelfServiceProfileTicketConnectionConfigOptions{
+						DomainAliases: &inputs.DomainAliases,
+					}
+				}
+			}
+
+			if inputs.DomainVerification != "" {
+				ticket.DomainAliasesConfig = &management.SelfServiceProfileTicketDomainAliasesConfig{
+					DomainVerification: &inputs.DomainVerification,
+				}
+			}
+
+			if len(inputs.EnabledClients) > 0 {
+				ticket.EnabledClients = &inputs.EnabledClients
+			}
+
+			for _, orgID := range inputs.OrganizationIDs {
+				organization := &management.SelfServiceProfileTicketEnabledOrganizations{
+					OrganizationID: auth0.String(orgID),
+				}
+				if selfServiceTicketAssignMembership.IsSet(cmd) {
+					organization.AssignMembershipOnLogin = auth0.Bool(inputs.AssignMembershipOnLogin)
+				}
+				if selfServiceTicketShowAsButton.IsSet(cmd) {
+					organization.ShowAsButton = auth0.Bool(inputs.ShowAsButton)
+				}
+				ticket.EnabledOrganizations = append(ticket.EnabledOrganizations, organization)
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.SelfServiceProfile.CreateTicket(cmd.Context(), inputs.ID, ticket)
+			}); err != nil {
+				return fmt.Errorf("failed to create a ticket for self-service profile with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.SelfServiceProfileTicket(ticket)
+			return nil
+		},
+	}
+
+	selfServiceTicketOrganizations.RegisterStringSlice(cmd, &inputs.OrganizationIDs, nil)
+	selfServiceTicketConnectionID.RegisterString(cmd, &inputs.ConnectionID, "")
+	selfServiceTicketConnectionName.RegisterString(cmd, &inputs.ConnectionName, "")
+	selfServiceTicketConnectionDisplayName.RegisterString(cmd, &inputs.ConnectionDisplayName, "")
+	selfServiceTicketDomainAliases.RegisterStringSlice(cmd, &inputs.DomainAliases, nil)
+	selfServiceTicketDomainVerification.RegisterString(cmd, &inputs.DomainVerification, "")
+	selfServiceTicketEnabledClients.RegisterStringSlice(cmd, &inputs.EnabledClients, nil)
+	selfServiceTicketAssignMembership.RegisterBool(cmd, &inputs.AssignMembershipOnLogin, false)
+	selfServiceTicketShowAsButton.RegisterBool(cmd, &inputs.ShowAsButton, false)
+	selfServiceTicketTTL.RegisterInt(cmd, &inputs.TTLSec, 0)
+	cmd.MarkFlagsMutuallyExclusive("connection-id", "connection-name")
+	cmd.MarkFlagsMutuallyExclusive("connection-id", "connection-display-name")
+	cmd.MarkFlagsMutuallyExclusive("connection-id", "domain-aliases")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+// applySelfServiceProfileInputs sets the settings of the profile that were passed as flags.
+func applySelfServiceProfileInputs(
+	profile *management.SelfServiceProfile,
+	allowedStrategies []string,
+	userAttributes, userAttributeProfileID, logoURL, primaryColor string,
+) error {
+	for _, strategy := range allowedStrategies {
+		if !slices.Contains(selfServiceProfileStrategies, strategy) {
+			return fmt.Errorf(
+				"invalid strategy %q, please use one of: %s",
+				strategy, strings.Join(selfServiceProfileStrategies, ", "),
+			)
+		}
+	}
+	if len(allowedStrategies) > 0 {
+		profile.AllowedStrategies = &allowedStrategies
+	}
+
+	if userAttributes != "" {
+		if err := json.Unmarshal([]byte(userAttributes), &profile.UserAttributes); err != nil {
+			return fmt.Errorf("invalid user attributes JSON: %w", err)
+		}
+	}
+
+	if userAttributeProfileID != "" {
+		profile.UserAttributeProfileID = &userAttributeProfileID
+	}
+
+	if logoURL != "" || primaryColor != "" {
+		profile.Branding = &management.Branding{}
+		if logoURL != "" {
+			profile.Branding.LogoURL = &logoURL
+		}
+		if primaryColor != "" {
+			profile.Branding.Colors = &management.BrandingColors{Primary: &primaryColor}
+		}
+	}
+
+	return nil
+}
+
+// mergeSelfServiceProfileBranding keeps the branding settings that aren't
+// updated, such as the page background when only the primary color is, as
+// the branding is replaced as a whole.
+func mergeSelfServiceProfileBranding(update, current *management.Branding) {
+	if update == nil || current == nil {
+		return
+	}
+
+	if update.LogoURL == nil {
+		update.LogoURL = current.LogoURL
+	}
+
+	if current.Colors != nil {
+		colors := *current.Colors
+		if update.Colors != nil {
+			colors.Primary = update.Colors.Primary
+		}
+		update.Colors = &colors
+	}
+}
+
+func (c *cli) selfServiceProfilePickerOptions(ctx context.Context) (pickerOptions, error) {
+	list, err := c.api.SelfServiceProfile.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, profile := range list.SelfServiceProfile {
+		value := profile.GetID()
+		label := fmt.Sprintf("%s %s", profile.GetName(), ansi.Faint("("+value+")"))
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no self-service profiles to choose from. Create one by running: `auth0 self-service-profiles create`")
+	}
+
+	return opts, nil
+}
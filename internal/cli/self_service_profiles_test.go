@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestApplySelfServiceProfileInputs(t *testing.T) {
+	t.Run("it sets the settings passed as flags", func(t *testing.T) {
+		profile := &management.SelfServiceProfile{}
+		err := applySelfServiceProfileInputs(
+			profile,
+			[]string{"oidc", "samlp"},
+			`[{"name":"email","description":"Email of the user","is_optional":false}]`,
+			"",
+			"https://example.com/logo.png",
+			"#635DFF",
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"oidc", "samlp"}, profile.GetAllowedStrategies())
+		require.Len(t, profile.UserAttributes, 1)
+		assert.Equal(t, "email", profile.UserAttributes[0].GetName())
+		assert.False(t, profile.UserAttributes[0].GetIsOptional())
+		assert.Equal(t, "https://example.com/logo.png", profile.GetBranding().GetLogoURL())
+		assert.Equal(t, "#635DFF", profile.GetBranding().GetColors().GetPrimary())
+		assert.Nil(t, profile.UserAttributeProfileID)
+	})
+
+	t.Run("it rejects unknown strategies", func(t *testing.T) {
+		err := applySelfServiceProfileInputs(&management.SelfServiceProfile{}, []string{"github"}, "", "", "", "")
+		assert.ErrorContains(t, err, `invalid strategy "github"`)
+	})
+
+	t.Run("it rejects invalid user attributes", func(t *testing.T) {
+		err := applySelfServiceProfileInputs(&management.SelfServiceProfile{}, nil, "{", "", "", "")
+		assert.ErrorContains(t, err, "invalid user attributes JSON")
+	})
+}
+
+func TestMergeSelfServiceProfileBranding(t *testing.T) {
+	current := &management.Branding{
+		LogoURL: auth0.String("https://example.com/logo.png"),
+		Colors: &management.BrandingColors{
+			Primary:        auth0.String("#635DFF"),
+			PageBackground: auth0.String("#000000"),
+		},
+	}
+
+	update := &management.Branding{Colors: &management.BrandingColors{Primary: auth0.String("#FF0000")}}
+	mergeSelfServiceProfileBranding(update, current)
+
+	assert.Equal(t, "https://example.com/logo.png", update.GetLogoURL())
+	assert.Equal(t, "#FF0000", update.GetColors().GetPrimary())
+	assert.Equal(t, "#000000", update.GetColors().GetPageBackground())
+
+	// The current branding is left untouched.
+	assert.Equal(t, "#635DFF", current.GetColors().GetPrimary())
+}
+
+func TestSelfServiceProfilesShowCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	profileAPI := mock.NewMockSelfServiceProfileAPI(ctrl)
+	profileAPI.EXPECT().
+		Read(gomock.Any(), "ssp_1").
+		Return(&management.SelfServiceProfile{
+			ID:                auth0.String("ssp_1"),
+			Name:              auth0.String("Enterprise SSO"),
+			AllowedStrategies: &[]string{"oidc"},
+		}, nil)
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+		api:      &auth0.API{SelfServiceProfile: profileAPI},
+	}
+
+	cmd := showSelfServiceProfileCmd(cli)
+	cmd.SetArgs([]string{"ssp_1"})
+	require.NoError(t, cmd.Execute())
+
+	// The ID is part of the output, even though the SDK omits it when marshaling profiles.
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+	assert.Equal(t, "ssp_1", output["id"])
+	assert.Equal(t, "Enterprise SSO", output["name"])
+}
+
+func TestSelfServiceProfilesCreateTicketCmd(t *testing.T) {
+	t.Run("it creates a ticket for a new connection of an organization", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		profileAPI := mock.NewMockSelfServiceProfileAPI(ctrl)
+		profileAPI.EXPECT().
+			CreateTicket(gomock.Any(), "ssp_1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, ticket *management.SelfServiceProfileTicket, _ ...management.RequestOption) error {
+				assert.Nil(t, ticket.ConnectionID)
+				assert.Equal(t, "acme-sso", ticket.GetConnectionConfig().GetName())
+				assert.Equal(t, []string{"acme.com"}, ticket.GetConnectionConfig().GetOptions().GetDomainAliases())
+				assert.Equal(t, "required", ticket.GetDomainAliasesConfig().GetDomainVerification())
+				require.Len(t, ticket.EnabledOrganizations, 1)
+				assert.Equal(t, "org_1", ticket.EnabledOrganizations[0].GetOrganizationID())
+				assert.True(t, ticket.EnabledOrganizations[0].GetAssignMembershipOnLogin())
+				assert.Nil(t, ticket.EnabledOrganizations[0].ShowAsButton)
+
+				ticket.Ticket = auth0.String("https://example.auth0.com/self-service/connections-flow?ticket=abc")
+				return nil
+			})
+
+		buf := &bytes.Buffer{}
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+			api:      &auth0.API{SelfServiceProfile: profileAPI},
+		}
+
+		cmd := createTicketSelfServiceProfileCmd(cli)
+		cmd.SetArgs([]string{
+			"ssp_1",
+			"--org-id", "org_1",
+			"--connection-name", "acme-sso",
+			"--domain-aliases", "acme.com",
+			"--domain-verification", "required",
+			"--assign-membership-on-login",
+		})
+		require.NoError(t, cmd.Execute())
+
+		assert.JSONEq(t, `{"ticket": "https://example.auth0.com/self-service/connections-flow?ticket=abc"}`, buf.String())
+	})
+
+	t.Run("it rejects an invalid domain verification", func(t *testing.T) {
+		cli := &cli{renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard}}
+
+		cmd := createTicketSelfServiceProfileCmd(cli)
+		cmd.SetArgs([]string{"ssp_1", "--connection-name", "acme-sso", "--domain-verification", "always"})
+		assert.ErrorContains(t, cmd.Execute(), `invalid domain verification "always"`)
+	})
+}
@@ -0,0 +1,720 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+type (
+	// tenantResource is a resource of a tenant, stripped of its read-only fields.
+	tenantResource struct {
+		// Key identifies the resource across tenants, as IDs differ from one tenant to another.
+		Key  string
+		ID   string
+		Data map[string]interface{}
+	}
+
+	// tenantResourceKind manages the resources of a kind when
+	// exporting, applying or comparing the configuration of a tenant.
+	tenantResourceKind interface {
+		// Name of the kind, also used as the name of its directory when exported.
+		Name() string
+		// KeyField is the field identifying a resource across tenants.
+		KeyField() string
+		List(ctx context.Context) ([]tenantResource, error)
+		Create(ctx context.Context, data map[string]interface{}) error
+		Update(ctx context.Context, id string, data map[string]interface{}) error
+		Delete(ctx context.Context, id string) error
+	}
+)
+
+type (
+	resourceServerTenantResourceKind struct {
+		api *auth0.API
+	}
+
+	roleTenantResourceKind struct {
+		api *auth0.API
+	}
+
+	clientTenantResourceKind struct {
+		api *auth0.API
+	}
+
+	connectionTenantResourceKind struct {
+		api *auth0.API
+	}
+
+	actionTenantResourceKind struct {
+		api *auth0.API
+	}
+)
+
+var (
+	resourceServerReadOnlyFields = []string{"id", "is_system", "client_id", "signing_secret"}
+	roleReadOnlyFields           = []string{"id"}
+	clientReadOnlyFields         = []string{
+		"client_id", "client_secret", "signing_keys", "tenant", "global", "callback_url_template",
+		"external_client_id", "external_metadata_type", "external_metadata_created_by",
+	}
+	// The enabled clients are applied on their own, by name, as client IDs differ from one tenant to another.
+	connectionReadOnlyFields = []string{"id", "enabled_clients", "provisioning_ticket_url"}
+	// Custom database connections keep their secrets in the configuration option.
+	connectionSecretOptions = []string{"client_secret", "signing_key", "configuration"}
+	// Secret values can't be read back, so secrets are left out of the configuration.
+	actionReadOnlyFields = []string{
+		"id", "secrets", "deployed_version", "status", "all_changes_deployed",
+		"built_at", "created_at", "updated_at", "deploy", "installed_integration_id", "integration",
+	}
+)
+
+var (
+	// actionBuildPollInterval is the time to wait between checks on the build of an action.
+	actionBuildPollInterval = time.Second
+
+	// actionBuildTimeout bounds how long to wait for an action to be built.
+	actionBuildTimeout = 2 * time.Minute
+)
+
+// tenantResourceKinds returns the kinds of resources managed declaratively,
+// in the order in which they must be created. Deletes happen in reverse order.
+func tenantResourceKinds(api *auth0.API) []tenantResourceKind {
+	return []tenantResourceKind{
+		&resourceServerTenantResourceKind{api},
+		&roleTenantResourceKind{api},
+		&clientTenantResourceKind{api},
+		&connectionTenantResourceKind{api},
+		&actionTenantResourceKind{api},
+	}
+}
+
+func tenantResourceKindNames(kinds []tenantResourceKind) []string {
+	names := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		names = append(names, kind.Name())
+	}
+	return names
+}
+
+// filterTenantResourceKinds returns the kinds matching the given names,
+// or all of them if no names are given, keeping their dependency order.
+func filterTenantResourceKinds(kinds []tenantResourceKind, names []string) ([]tenantResourceKind, error) {
+	if len(names) == 0 {
+		return kinds, nil
+	}
+
+	selected := make(map[string]bool, len(names))
+	for _, name := range names {
+		selected[name] = true
+	}
+
+	var filtered []tenantResourceKind
+	for _, kind := range kinds {
+		if selected[kind.Name()] {
+			filtered = append(filtered, kind)
+			delete(selected, kind.Name())
+		}
+	}
+
+	for name := range selected {
+		return nil, fmt.Errorf("unsupported resource %q, expected one of: %v", name, tenantResourceKindNames(kinds))
+	}
+
+	return filtered, nil
+}
+
+// newTenantResource converts the API representation of a resource into a tenant resource.
+func newTenantResource(id, key string, value interface{}, readOnlyFields []string) (tenantResource, error) {
+	data, err := toTenantResourceData(value)
+	if err != nil {
+		return tenantResource{}, err
+	}
+
+	for _, field := range readOnlyFields {
+		delete(data, field)
+	}
+
+	return tenantResource{Key: key, ID: id, Data: data}, nil
+}
+
+// toTenantResourceData converts a value into its JSON object representation.
+func toTenantResourceData(value interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// fromTenantResourceData converts the data of a resource into its API representation,
+// leaving out the read-only fields and any field listed in omitFields.
+func fromTenantResourceData(data map[string]interface{}, value interface{}, readOnlyFields []string, omitFields ...string) error {
+	writable := make(map[string]interface{}, len(data))
+	for field, fieldValue := range data {
+		writable[field] = fieldValue
+	}
+
+	for _, field := range append(readOnlyFields, omitFields...) {
+		delete(writable, field)
+	}
+
+	raw, err := json.Marshal(writable)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, value)
+}
+
+// tenantResourceDataContains reports whether every field of the desired data
+// matches the current data. Fields only set on the current data are ignored,
+// as they usually hold defaults applied by the API.
+func tenantResourceDataContains(current, desired interface{}) bool {
+	desiredMap, ok := desired.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(current, desired)
+	}
+
+	currentMap, ok := current.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for field, value := range desiredMap {
+		if !tenantResourceDataContains(currentMap[field], value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (k *resourceServerTenantResourceKind) Name() string {
+	return "resource-servers"
+}
+
+func (k *resourceServerTenantResourceKind) KeyField() string {
+	return "identifier"
+}
+
+func (k *resourceServerTenantResourceKind) List(ctx context.Context) ([]tenantResource, error) {
+	var resources []tenantResource
+
+	var page int
+	for {
+		list, err := k.api.ResourceServer.List(ctx, management.Page(page))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resourceServer := range list.ResourceServers {
+			if resourceServer.GetIsSystem() {
+				continue
+			}
+
+			resource, err := newTenantResource(
+				resourceServer.GetID(),
+				resourceServer.GetIdentifier(),
+				resourceServer,
+				resourceServerReadOnlyFields,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, resource)
+		}
+
+		if !list.HasNext() {
+			break
+		}
+
+		page++
+	}
+
+	return resources, nil
+}
+
+func (k *resourceServerTenantResourceKind) Create(ctx context.Context, data map[string]interface{}) error {
+	var resourceServer management.ResourceServer
+	if err := fromTenantResourceData(data, &resourceServer, resourceServerReadOnlyFields); err != nil {
+		return err
+	}
+
+	return k.api.ResourceServer.Create(ctx, &resourceServer)
+}
+
+func (k *resourceServerTenantResourceKind) Update(ctx context.Context, id string, data map[string]interface{}) error {
+	var resourceServer management.ResourceServer
+	if err := fromTenantResourceData(data, &resourceServer, resourceServerReadOnlyFields, "identifier"); err != nil {
+		return err
+	}
+
+	return k.api.ResourceServer.Update(ctx, id, &resourceServer)
+}
+
+func (k *resourceServerTenantResourceKind) Delete(ctx context.Context, id string) error {
+	return k.api.ResourceServer.Delete(ctx, id)
+}
+
+func (k *roleTenantResourceKind) Name() string {
+	return "roles"
+}
+
+func (k *roleTenantResourceKind) KeyField() string {
+	return "name"
+}
+
+func (k *roleTenantResourceKind) List(ctx context.Context) ([]tenantResource, error) {
+	var resources []tenantResource
+
+	var page int
+	for {
+		list, err := k.api.Role.List(ctx, management.Page(page))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, role := range list.Roles {
+			resource, err := newTenantResource(role.GetID(), role.GetName(), role, roleReadOnlyFields)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, resource)
+		}
+
+		if !list.HasNext() {
+			break
+		}
+
+		page++
+	}
+
+	return resources, nil
+}
+
+func (k *roleTenantResourceKind) Create(ctx context.Context, data map[string]interface{}) error {
+	var role management.Role
+	if err := fromTenantResourceData(data, &role, roleReadOnlyFields); err != nil {
+		return err
+	}
+
+	return k.api.Role.Create(ctx, &role)
+}
+
+func (k *roleTenantResourceKind) Update(ctx context.Context, id string, data map[string]interface{}) error {
+	var role management.Role
+	if err := fromTenantResourceData(data, &role, roleReadOnlyFields); err != nil {
+		return err
+	}
+
+	return k.api.Role.Update(ctx, id, &role)
+}
+
+func (k *roleTenantResourceKind) Delete(ctx context.Context, id string) error {
+	return k.api.Role.Delete(ctx, id)
+}
+
+func (k *clientTenantResourceKind) Name() string {
+	return "clients"
+}
+
+func (k *clientTenantResourceKind) KeyField() string {
+	return "name"
+}
+
+func (k *clientTenantResourceKind) List(ctx context.Context) ([]tenantResource, error) {
+	var resources []tenantResource
+
+	var page int
+	for {
+		list, err := k.api.Client.List(ctx, management.Page(page), management.Parameter("is_global", "false"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, client := range list.Clients {
+			resource, err := newTenantResource(client.GetClientID(), client.GetName(), client, clientReadOnlyFields)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, resource)
+		}
+
+		if !list.HasNext() {
+			break
+		}
+
+		page++
+	}
+
+	return resources, nil
+}
+
+func (k *clientTenantResourceKind) Create(ctx context.Context, data map[string]interface{}) error {
+	var client management.Client
+	if err := fromTenantResourceData(data, &client, clientReadOnlyFields); err != nil {
+		return err
+	}
+
+	return k.api.Client.Create(ctx, &client)
+}
+
+func (k *clientTenantResourceKind) Update(ctx context.Context, id string, data map[string]interface{}) error {
+	var client management.Client
+	if err := fromTenantResourceData(data, &client, clientReadOnlyFields); err != nil {
+		return err
+	}
+
+	return k.api.Client.Update(ctx, id, &client)
+}
+
+func (k *clientTenantResourceKind) Delete(ctx context.Context, id string) error {
+	return k.api.Client.Delete(ctx, id)
+}
+
+func (k *connectionTenantResourceKind) Name() string {
+	return "connections"
+}
+
+func (k *connectionTenantResourceKind) KeyField() string {
+	return "name"
+}
+
+func (k *connectionTenantResourceKind) List(ctx context.Context) ([]tenantResource, error) {
+	clients, err := (&clientTenantResourceKind{k.api}).List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clientNames := make(map[string]string, len(clients))
+	for _, client := range clients {
+		clientNames[client.ID] = client.Key
+	}
+
+	var resources []tenantResource
+
+	var page int
+	for {
+		list, err := k.api.Connection.List(ctx, management.Page(page))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, connection := range list.Connections {
+			resource, err := newTenantResource(
+				connection.GetID(),
+				connection.GetName(),
+				connection,
+				connectionReadOnlyFields,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if options, ok := resource.Data["options"].(map[string]interface{}); ok {
+				for option := range options {
+					if isConnectionSecretOption(option) {
+						delete(options, option)
+					}
+				}
+			}
+
+			enabledClients, err := getConnectionEnabledClients(ctx, k.api, connection.GetID())
+			if err != nil {
+				return nil, err
+			}
+
+			// Clients missing from the list, such as the global one, are left out.
+			names := make([]string, 0, len(enabledClients))
+			for _, client := range enabledClients {
+				if name, ok := clientNames[client.GetClientID()]; ok {
+					names = append(names, name)
+				}
+			}
+			sort.Strings(names)
+
+			enabledClientNames := make([]interface{}, 0, len(names))
+			for _, name := range names {
+				enabledClientNames = append(enabledClientNames, name)
+			}
+			resource.Data["enabled_clients"] = enabledClientNames
+
+			resources = append(resources, resource)
+		}
+
+		if !list.HasNext() {
+			break
+		}
+
+		page++
+	}
+
+	return resources, nil
+}
+
+func (k *connectionTenantResourceKind) Create(ctx context.Context, data map[string]interface{}) error {
+	var connection management.Connection
+	if err := fromTenantResourceData(data, &connection, connectionReadOnlyFields); err != nil {
+		return err
+	}
+
+	if err := k.api.Connection.Create(ctx, &connection); err != nil {
+		return err
+	}
+
+	return k.updateEnabledClients(ctx, connection.GetID(), data, nil)
+}
+
+func (k *connectionTenantResourceKind) Update(ctx context.Context, id string, data map[string]interface{}) error {
+	var connection management.Connection
+	if err := fromTenantResourceData(data, &connection, connectionReadOnlyFields); err != nil {
+		return err
+	}
+
+	// The name and the strategy of a connection can't be changed once created.
+	connection.Name = nil
+	connection.Strategy = nil
+
+	// The options are replaced as a whole, so the secrets left out of
+	// the configuration are carried over from the current connection.
+	if options, ok := data["options"].(map[string]interface{}); ok {
+		current, err := k.api.Connection.Read(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		currentOptions, err := toTenantResourceData(current.Options)
+		if err != nil {
+			return err
+		}
+
+		merged := make(map[string]interface{}, len(options))
+		for option, value := range options {
+			merged[option] = value
+		}
+		for option, value := range currentOptions {
+			if _, ok := merged[option]; !ok && isConnectionSecretOption(option) {
+				merged[option] = value
+			}
+		}
+
+		if err := fromTenantResourceData(map[string]interface{}{"strategy": current.GetStrategy(), "options": merged}, &connection, nil); err != nil {
+			return err
+		}
+		connection.Strategy = nil
+	}
+
+	if err := k.api.Connection.Update(ctx, id, &connection); err != nil {
+		return err
+	}
+
+	if _, ok := data["enabled_clients"]; !ok {
+		return nil
+	}
+
+	current, err := getConnectionEnabledClients(ctx, k.api, id)
+	if err != nil {
+		return err
+	}
+
+	return k.updateEnabledClients(ctx, id, data, current)
+}
+
+// updateEnabledClients enables the clients named in the data for the connection,
+// and disables the other ones currently enabled. Connections without enabled
+// clients in their data are left untouched.
+func (k *connectionTenantResourceKind) updateEnabledClients(
+	ctx context.Context,
+	id string,
+	data map[string]interface{},
+	current []management.ConnectionEnabledClient,
+) error {
+	names, ok := data["enabled_clients"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	clients, err := (&clientTenantResourceKind{k.api}).List(ctx)
+	if err != nil {
+		return err
+	}
+
+	clientIDs := make(map[string][]string, len(clients))
+	for _, client := range clients {
+		clientIDs[client.Key] = append(clientIDs[client.Key], client.ID)
+	}
+
+	currentlyEnabled := make(map[string]bool, len(current))
+	for _, client := range current {
+		currentlyEnabled[client.GetClientID()] = true
+	}
+
+	var changes []management.ConnectionEnabledClient
+	enabled := make(map[string]bool, len(names))
+	for _, value := range names {
+		name, _ := value.(string)
+
+		ids := clientIDs[name]
+		switch {
+		case len(ids) == 0:
+			return fmt.Errorf("failed to find enabled client %q", name)
+		case len(ids) > 1:
+			return fmt.Errorf("found %d clients named %q, rename them so that the enabled client can be told apart", len(ids), name)
+		}
+
+		enabled[ids[0]] = true
+		if !currentlyEnabled[ids[0]] {
+			changes = append(changes, management.ConnectionEnabledClient{ClientID: &ids[0], Status: auth0.Bool(true)})
+		}
+	}
+
+	for _, client := range current {
+		if !enabled[client.GetClientID()] {
+			changes = append(changes, management.ConnectionEnabledClient{ClientID: client.ClientID, Status: auth0.Bool(false)})
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return k.api.Connection.UpdateEnabledClients(ctx, id, changes)
+}
+
+// isConnectionSecretOption reports whether the connection option holds a secret,
+// such as the client secret of a social connection or the private key of a SAML one.
+// Secrets can't be applied back from an export, so they're left out of it.
+func isConnectionSecretOption(name string) bool {
+	normalized := strings.ToLower(name)
+	for _, option := range connectionSecretOptions {
+		if normalized == option {
+			return true
+		}
+	}
+
+	normalized = strings.NewReplacer("_", "", "-", "").Replace(normalized)
+
+	return strings.Contains(normalized, "secret") ||
+		strings.HasSuffix(normalized, "password") ||
+		strings.HasSuffix(normalized, "key") ||
+		strings.HasSuffix(normalized, "token")
+}
+
+func (k *connectionTenantResourceKind) Delete(ctx context.Context, id string) error {
+	return k.api.Connection.Delete(ctx, id)
+}
+
+func (k *actionTenantResourceKind) Name() string {
+	return "actions"
+}
+
+func (k *actionTenantResourceKind) KeyField() string {
+	return "name"
+}
+
+func (k *actionTenantResourceKind) List(ctx context.Context) ([]tenantResource, error) {
+	var resources []tenantResource
+
+	var page int
+	for {
+		list, err := k.api.Action.List(ctx, management.Page(page))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, action := range list.Actions {
+			resource, err := newTenantResource(action.GetID(), action.GetName(), action, actionReadOnlyFields)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, resource)
+		}
+
+		if !list.HasNext() || len(list.Actions) == 0 {
+			break
+		}
+
+		page++
+	}
+
+	return resources, nil
+}
+
+func (k *actionTenantResourceKind) Create(ctx context.Context, data map[string]interface{}) error {
+	var action management.Action
+	if err := fromTenantResourceData(data, &action, actionReadOnlyFields); err != nil {
+		return err
+	}
+
+	if err := k.api.Action.Create(ctx, &action); err != nil {
+		return err
+	}
+
+	return k.deploy(ctx, action.GetID())
+}
+
+func (k *actionTenantResourceKind) Update(ctx context.Context, id string, data map[string]interface{}) error {
+	var action management.Action
+	if err := fromTenantResourceData(data, &action, actionReadOnlyFields); err != nil {
+		return err
+	}
+
+	if err := k.api.Action.Update(ctx, id, &action); err != nil {
+		return err
+	}
+
+	return k.deploy(ctx, id)
+}
+
+// deploy waits for the action to be built, then deploys it, so that
+// the applied code is the one that runs in the flows of the tenant.
+// Builds that don't complete within actionBuildTimeout fail the deploy.
+func (k *actionTenantResourceKind) deploy(ctx context.Context, id string) error {
+	timeout := time.After(actionBuildTimeout)
+
+	for {
+		action, err := k.api.Action.Read(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		switch action.GetStatus() {
+		case management.ActionStatusBuilt:
+			_, err := k.api.Action.Deploy(ctx, id)
+			return err
+		case management.ActionStatusFailed:
+			return fmt.Errorf("failed to build action with ID %q", id)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout:
+			return fmt.Errorf("the action with ID %q was not built within %s", id, actionBuildTimeout)
+		case <-time.After(actionBuildPollInterval):
+		}
+	}
+}
+
+func (k *actionTenantResourceKind) Delete(ctx context.Context, id string) error {
+	return k.api.Action.Delete(ctx, id)
+}
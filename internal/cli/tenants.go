@@ -14,15 +14,19 @@ var tenantDomain = Argument{
 
 func tenantsCmd(cli *cli) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "tenants",
-		Short: "Manage configured tenants",
-		Long:  "Manage configured tenants.",
+		Use:     "tenants",
+		Aliases: []string{"tenant"},
+		Short:   "Manage configured tenants",
+		Long:    "Manage configured tenants.",
 	}
 
 	cmd.SetUsageTemplate(resourceUsageTemplate())
 	cmd.AddCommand(useTenantCmd(cli))
 	cmd.AddCommand(listTenantCmd(cli))
 	cmd.AddCommand(openTenantCmd(cli))
+	cmd.AddCommand(exportTenantCmd(cli))
+	cmd.AddCommand(applyTenantCmd(cli))
+	cmd.AddCommand(diffTenantCmd(cli))
 	return cmd
 }
 
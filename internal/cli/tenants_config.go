@@ -0,0 +1,562 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+const (
+	tenantChangeCreate = "create"
+	tenantChangeUpdate = "update"
+	tenantChangeDelete = "delete"
+)
+
+var (
+	tenantConfigDir = Flag{
+		Name:       "Directory",
+		LongForm:   "dir",
+		ShortForm:  "d",
+		Help:       "Directory holding the configuration of the tenant.",
+		IsRequired: true,
+	}
+
+	tenantConfigResources = Flag{
+		Name:      "Resources",
+		LongForm:  "resources",
+		ShortForm: "r",
+		Help: "Comma-separated list of resources to include. Defaults to all the supported ones. " +
+			"Options include: resource-servers, roles, clients, connections and actions.",
+	}
+
+	tenantConfigFormat = Flag{
+		Name:     "Format",
+		LongForm: "format",
+		Help:     "Format of the exported files. Options include: 'yaml' and 'json'.",
+	}
+
+	tenantConfigPlanOnly = Flag{
+		Name:     "Plan Only",
+		LongForm: "plan-only",
+		Help:     "Only print the changes that would be applied to the tenant.",
+	}
+
+	tenantConfigPrune = Flag{
+		Name:     "Prune",
+		LongForm: "prune",
+		Help:     "Delete the resources of the tenant that are missing from the configuration.",
+	}
+)
+
+// tenantChange is a change planned to make a tenant match its configuration.
+type tenantChange struct {
+	display.TenantChange
+
+	kind tenantResourceKind
+	id   string
+	data map[string]interface{}
+}
+
+func exportTenantCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Dir       string
+		Resources []string
+		Format    string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Args:  cobra.NoArgs,
+		Short: "Export the configuration of the tenant",
+		Long: "Export the configuration of the active tenant to a directory, with one file per resource " +
+			"grouped in a directory per kind of resource. The code of actions is written to separate `.js` files, " +
+			"and the clients enabled for connections are referred to by name.\n\n" +
+			"Only resource servers, roles, clients, connections and actions are exported. " +
+			"Use `auth0 terraform generate` to manage the rest of the configuration of the tenant.\n\n" +
+			"Read-only fields and secrets, such as the client secrets and signing keys of connections, are left out, " +
+			"and the secrets of existing resources are kept when the configuration is applied. " +
+			"Use `auth0 tenants apply` to apply the configuration to a tenant.",
+		Example: `  auth0 tenants export --dir ./tenant
+  auth0 tenants export --dir ./tenant --resources clients,actions
+  auth0 tenants export -d ./tenant -r roles --format json
+  auth0 tenants export -d ./tenant --tenant dev.auth0.com`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Format != "yaml" && inputs.Format != "json" {
+				return fmt.Errorf("invalid format %q, please use 'yaml' or 'json'", inputs.Format)
+			}
+
+			kinds, err := filterTenantResourceKinds(tenantResourceKinds(cli.api), inputs.Resources)
+			if err != nil {
+				return err
+			}
+
+			var total int
+			for _, kind := range kinds {
+				var resources []tenantResource
+				if err := ansi.Spinner("Exporting "+kind.Name(), func() (err error) {
+					resources, err = kind.List(cmd.Context())
+					return err
+				}); err != nil {
+					return fmt.Errorf("failed to list %s: %w", kind.Name(), err)
+				}
+
+				sortTenantResources(resources)
+
+				// Resources are matched by key when applied, so duplicates couldn't be applied back.
+				if _, err := tenantResourcesByKey(kind, resources); err != nil {
+					return fmt.Errorf("failed to export %s: %w", kind.Name(), err)
+				}
+
+				if err := writeTenantResources(inputs.Dir, kind, resources, inputs.Format); err != nil {
+					return fmt.Errorf("failed to export %s: %w", kind.Name(), err)
+				}
+
+				total += len(resources)
+			}
+
+			cli.renderer.Infof("Exported %d resources to %s", total, ansi.Bold(inputs.Dir))
+			cli.renderer.Infof(
+				"Only resource servers, roles, clients, connections and actions are exported, " +
+					"use `auth0 terraform generate` to export the rest of the configuration of the tenant",
+			)
+
+			return nil
+		},
+	}
+
+	tenantConfigDir.RegisterString(cmd, &inputs.Dir, "")
+	tenantConfigResources.RegisterStringSlice(cmd, &inputs.Resources, nil)
+	tenantConfigFormat.RegisterString(cmd, &inputs.Format, "yaml")
+
+	return cmd
+}
+
+func applyTenantCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Dir       string
+		Resources []string
+		PlanOnly  bool
+		Prune     bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Args:  cobra.NoArgs,
+		Short: "Apply a configuration to the tenant",
+		Long: "Apply a configuration exported with `auth0 tenants export` to the active tenant.\n\n" +
+			"Resources are matched by name, or by identifier for resource servers, so that a configuration " +
+			"exported from one tenant can be applied to another. Resources missing from the tenant are created " +
+			"and resources that differ are updated. Resources missing from the configuration are only deleted " +
+			"with `--prune`, except for the application the CLI is authenticated with. " +
+			"Kinds of resources without a directory in the configuration are left untouched.\n\n" +
+			"Only resource servers, roles, clients, connections and actions are applied, and the rest of the " +
+			"configuration of the tenant is left untouched. Use `auth0 terraform generate` to manage it.\n\n" +
+			"Actions are deployed once created or updated. " +
+			"Changes are applied in dependency order and applying stops at the first change that fails. Use `--plan-only` to review them without applying them.",
+		Example: `  auth0 tenants apply --dir ./tenant
+  auth0 tenants apply --dir ./tenant --plan-only
+  auth0 tenants apply --dir ./tenant --plan-only --json
+  auth0 tenants apply --dir ./tenant --prune --plan-only
+  auth0 tenants apply -d ./tenant --resources clients,actions
+  auth0 tenants apply -d ./tenant --tenant prod.auth0.com --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kinds, err := filterTenantResourceKinds(tenantResourceKinds(cli.api), inputs.Resources)
+			if err != nil {
+				return err
+			}
+
+			// Never delete the application that the CLI is authenticated with.
+			tenant, _ := cli.Config.GetTenant(cli.tenant)
+
+			var changes []tenantChange
+			if err := ansi.Waiting(func() (err error) {
+				changes, err = planTenantChanges(cmd.Context(), inputs.Dir, kinds, tenant.ClientID)
+				return err
+			}); err != nil {
+				return err
+			}
+
+			var skipped int
+			if !inputs.Prune {
+				changes, skipped = withoutTenantDeletes(changes)
+			}
+
+			planned := make([]display.TenantChange, 0, len(changes))
+			for _, change := range changes {
+				planned = append(planned, change.TenantChange)
+			}
+
+			cli.renderer.TenantPlan(planned)
+
+			if skipped > 0 {
+				cli.renderer.Infof("Skipped deleting %d resources missing from the configuration, use --prune to delete them", skipped)
+			}
+
+			if inputs.PlanOnly || len(changes) == 0 {
+				return nil
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm(fmt.Sprintf("Are you sure you want to apply %d changes to the tenant?", len(changes))); !confirmed {
+					return nil
+				}
+			}
+
+			var applied int
+			if err := ansi.Spinner("Applying changes", func() (err error) {
+				applied, err = applyTenantChanges(cmd.Context(), changes)
+				return err
+			}); err != nil {
+				return fmt.Errorf("applied %d of %d changes to the tenant before failing: %w", applied, len(changes), err)
+			}
+
+			cli.renderer.Infof("Applied %d changes to the tenant", len(changes))
+
+			return nil
+		},
+	}
+
+	tenantConfigDir.RegisterString(cmd, &inputs.Dir, "")
+	tenantConfigResources.RegisterStringSlice(cmd, &inputs.Resources, nil)
+	tenantConfigPlanOnly.RegisterBool(cmd, &inputs.PlanOnly, false)
+	tenantConfigPrune.RegisterBool(cmd, &inputs.Prune, false)
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+// planTenantChanges compares the configuration found in the directory with the
+// tenant. Creates and updates are planned in dependency order, followed by
+// deletes in reverse dependency order. The resource with the protected ID,
+// if any, is never deleted.
+func planTenantChanges(ctx context.Context, dir string, kinds []tenantResourceKind, protectedID string) ([]tenantChange, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("failed to read configuration directory %q: %w", dir, err)
+	}
+
+	var changes, deletes []tenantChange
+	for _, kind := range kinds {
+		desired, found, err := readTenantResources(dir, kind)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", kind.Name(), err)
+		}
+		if !found {
+			continue
+		}
+
+		current, err := kind.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", kind.Name(), err)
+		}
+
+		sortTenantResources(current)
+
+		currentByKey, err := tenantResourcesByKey(kind, current)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan %s: %w", kind.Name(), err)
+		}
+
+		desiredKeys := make(map[string]bool, len(desired))
+		for _, resource := range desired {
+			desiredKeys[resource.Key] = true
+
+			existing, ok := currentByKey[resource.Key]
+			switch {
+			case !ok:
+				changes = append(changes, newTenantChange(tenantChangeCreate, kind, "", resource.Key, resource.Data))
+			case !tenantResourceDataContains(existing.Data, resource.Data):
+				changes = append(changes, newTenantChange(tenantChangeUpdate, kind, existing.ID, resource.Key, resource.Data))
+			}
+		}
+
+		var kindDeletes []tenantChange
+		for _, resource := range current {
+			if !desiredKeys[resource.Key] && resource.ID != protectedID {
+				kindDeletes = append(kindDeletes, newTenantChange(tenantChangeDelete, kind, resource.ID, resource.Key, nil))
+			}
+		}
+		deletes = append(kindDeletes, deletes...)
+	}
+
+	return append(changes, deletes...), nil
+}
+
+// withoutTenantDeletes leaves the deletes out of the changes,
+// returning how many of them were left out.
+func withoutTenantDeletes(changes []tenantChange) ([]tenantChange, int) {
+	kept := make([]tenantChange, 0, len(changes))
+	for _, change := range changes {
+		if change.Action != tenantChangeDelete {
+			kept = append(kept, change)
+		}
+	}
+
+	return kept, len(changes) - len(kept)
+}
+
+func newTenantChange(action string, kind tenantResourceKind, id, key string, data map[string]interface{}) tenantChange {
+	return tenantChange{
+		TenantChange: display.TenantChange{
+			Action:   action,
+			Resource: kind.Name(),
+			Key:      key,
+		},
+		kind: kind,
+		id:   id,
+		data: data,
+	}
+}
+
+// applyTenantChanges applies the changes in order, stopping at the first one
+// that fails so that the changes depending on it aren't applied. It returns
+// how many changes were applied.
+func applyTenantChanges(ctx context.Context, changes []tenantChange) (int, error) {
+	for i, change := range changes {
+		if err := applyTenantChange(ctx, change); err != nil {
+			return i, err
+		}
+	}
+
+	return len(changes), nil
+}
+
+func applyTenantChange(ctx context.Context, change tenantChange) error {
+	var err error
+	switch change.Action {
+	case tenantChangeCreate:
+		err = change.kind.Create(ctx, change.data)
+	case tenantChangeUpdate:
+		err = change.kind.Update(ctx, change.id, change.data)
+	case tenantChangeDelete:
+		err = change.kind.Delete(ctx, change.id)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to %s %s %q: %w", change.Action, change.Resource, change.Key, err)
+	}
+
+	return nil
+}
+
+// writeTenantResources writes each resource of the kind to its own file, replacing
+// any previous export. Code is written to a separate JavaScript file.
+func writeTenantResources(dir string, kind tenantResourceKind, resources []tenantResource, format string) error {
+	kindDir := filepath.Join(dir, kind.Name())
+
+	if err := os.MkdirAll(kindDir, 0755); err != nil {
+		return err
+	}
+
+	previous, err := os.ReadDir(kindDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range previous {
+		if !entry.IsDir() && isTenantConfigFile(entry.Name(), ".js") {
+			if err := os.Remove(filepath.Join(kindDir, entry.Name())); err != nil {
+				return err
+			}
+		}
+	}
+
+	used := map[string]bool{}
+	for _, resource := range resources {
+		base := tenantResourceFileName(resource.Key, used)
+
+		data := make(map[string]interface{}, len(resource.Data))
+		for field, value := range resource.Data {
+			data[field] = value
+		}
+
+		if code, ok := data["code"].(string); ok {
+			delete(data, "code")
+			if err := os.WriteFile(filepath.Join(kindDir, base+".js"), []byte(code), 0600); err != nil {
+				return err
+			}
+		}
+
+		var content []byte
+		if format == "json" {
+			content, err = json.MarshalIndent(data, "", "  ")
+		} else {
+			content, err = yaml.Marshal(data)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(kindDir, base+"."+format), content, 0600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readTenantResources reads the resources of the kind from the directory. It reports
+// whether the kind has a directory, as kinds without one must be left untouched.
+func readTenantResources(dir string, kind tenantResourceKind) ([]tenantResource, bool, error) {
+	kindDir := filepath.Join(dir, kind.Name())
+
+	entries, err := os.ReadDir(kindDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var resources []tenantResource
+	keys := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || !isTenantConfigFile(entry.Name()) {
+			continue
+		}
+
+		path := filepath.Join(kindDir, entry.Name())
+
+		data, err := readTenantResourceFile(path)
+		if err != nil {
+			return nil, true, fmt.Errorf("failed to parse %q: %w", path, err)
+		}
+
+		codePath := strings.TrimSuffix(path, filepath.Ext(path)) + ".js"
+		if code, err := os.ReadFile(codePath); err == nil {
+			data["code"] = string(code)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, true, err
+		}
+
+		key, _ := data[kind.KeyField()].(string)
+		if key == "" {
+			return nil, true, fmt.Errorf("missing required field %q in %q", kind.KeyField(), path)
+		}
+		if other, ok := keys[key]; ok {
+			return nil, true, fmt.Errorf("%q and %q both define %s %q", other, path, kind.KeyField(), key)
+		}
+		keys[key] = path
+
+		resources = append(resources, tenantResource{Key: key, Data: data})
+	}
+
+	return resources, true, nil
+}
+
+func readTenantResourceFile(path string) (map[string]interface{}, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if filepath.Ext(path) == ".json" {
+		err = json.Unmarshal(content, &value)
+	} else {
+		err = yaml.Unmarshal(content, &value)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Round-trip through JSON so that values compare equal to the ones read from the API.
+	return toTenantResourceData(yamlToJSONValue(value))
+}
+
+// yamlToJSONValue converts the maps decoded from YAML, which are keyed by
+// interface{} values, into maps keyed by strings that can be encoded as JSON.
+func yamlToJSONValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(typed))
+		for key, item := range typed {
+			converted[fmt.Sprint(key)] = yamlToJSONValue(item)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(typed))
+		for i, item := range typed {
+			converted[i] = yamlToJSONValue(item)
+		}
+		return converted
+	default:
+		return value
+	}
+}
+
+func isTenantConfigFile(name string, extraExtensions ...string) bool {
+	switch ext := filepath.Ext(name); ext {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		for _, extra := range extraExtensions {
+			if ext == extra {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// tenantResourceFileName returns a unique file name, without
+// extension, for the resource identified by the key.
+func tenantResourceFileName(key string, used map[string]bool) string {
+	base := sanitizeResourceName(key)
+	if base == "" {
+		base = "resource"
+	}
+
+	name := base
+	for i := 2; used[name]; i++ {
+		name = base + "_" + strconv.Itoa(i)
+	}
+	used[name] = true
+
+	return name
+}
+
+// tenantResourcesByKey indexes the resources by key. It fails when resources share
+// a key, as they couldn't be told apart when matched across tenants.
+func tenantResourcesByKey(kind tenantResourceKind, resources []tenantResource) (map[string]tenantResource, error) {
+	byKey := make(map[string]tenantResource, len(resources))
+	for _, resource := range resources {
+		if other, ok := byKey[resource.Key]; ok {
+			return nil, fmt.Errorf(
+				"%q and %q both have the %s %q, rename one of them so that they can be told apart",
+				other.ID,
+				resource.ID,
+				kind.KeyField(),
+				resource.Key,
+			)
+		}
+		byKey[resource.Key] = resource
+	}
+
+	return byKey, nil
+}
+
+// sortTenantResources sorts resources by key, so that exports and plans are stable.
+func sortTenantResources(resources []tenantResource) {
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Key < resources[j].Key
+	})
+}
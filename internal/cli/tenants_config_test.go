@@ -0,0 +1,365 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func TestFilterTenantResourceKinds(t *testing.T) {
+	kinds := tenantResourceKinds(&auth0.API{})
+
+	filtered, err := filterTenantResourceKinds(kinds, []string{"actions", "roles"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"roles", "actions"}, tenantResourceKindNames(filtered))
+
+	_, err = filterTenantResourceKinds(kinds, []string{"rules"})
+	assert.EqualError(t, err, `unsupported resource "rules", expected one of: [resource-servers roles clients connections actions]`)
+}
+
+func TestTenantResourceDataContains(t *testing.T) {
+	current := map[string]interface{}{
+		"name":      "My App",
+		"callbacks": []interface{}{"https://example.com"},
+		"jwt_configuration": map[string]interface{}{
+			"alg":                 "RS256",
+			"lifetime_in_seconds": float64(36000),
+		},
+	}
+
+	assert.True(t, tenantResourceDataContains(current, map[string]interface{}{
+		"name":              "My App",
+		"jwt_configuration": map[string]interface{}{"alg": "RS256"},
+	}))
+	assert.False(t, tenantResourceDataContains(current, map[string]interface{}{
+		"callbacks": []interface{}{"https://example.com", "https://example.org"},
+	}))
+	assert.False(t, tenantResourceDataContains(current, map[string]interface{}{
+		"jwt_configuration": map[string]interface{}{"alg": "HS256"},
+	}))
+}
+
+func TestWriteAndReadTenantResources(t *testing.T) {
+	dir := t.TempDir()
+	kind := &actionTenantResourceKind{}
+
+	resources := []tenantResource{
+		{
+			Key: "Log in",
+			Data: map[string]interface{}{
+				"name":                "Log in",
+				"code":                "exports.onExecutePostLogin = async () => {};",
+				"supported_triggers":  []interface{}{map[string]interface{}{"id": "post-login", "version": "v3"}},
+				"runtime":             "node18",
+				"dependencies":        []interface{}{},
+				"timeout_in_seconds":  float64(10),
+				"disable_async_login": false,
+			},
+		},
+	}
+
+	for _, format := range []string{"yaml", "json"} {
+		t.Run(format, func(t *testing.T) {
+			require.NoError(t, writeTenantResources(dir, kind, resources, format))
+
+			code, err := os.ReadFile(filepath.Join(dir, "actions", "log_in.js"))
+			require.NoError(t, err)
+			assert.Equal(t, "exports.onExecutePostLogin = async () => {};", string(code))
+
+			entries, err := os.ReadDir(filepath.Join(dir, "actions"))
+			require.NoError(t, err)
+			assert.Len(t, entries, 2, "previous exports must be replaced")
+
+			actual, found, err := readTenantResources(dir, kind)
+			require.NoError(t, err)
+			assert.True(t, found)
+			assert.Equal(t, resources, actual)
+		})
+	}
+
+	_, found, err := readTenantResources(dir, &roleTenantResourceKind{})
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestReadTenantResourcesRejectsDuplicateKeys(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "roles"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "roles", "admin.yaml"), []byte("name: Admin\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "roles", "admin_2.yaml"), []byte("name: Admin\n"), 0600))
+
+	_, _, err := readTenantResources(dir, &roleTenantResourceKind{})
+	assert.ErrorContains(t, err, `both define name "Admin"`)
+}
+
+func TestTenantResourcesByKey(t *testing.T) {
+	kind := &clientTenantResourceKind{}
+
+	byKey, err := tenantResourcesByKey(kind, []tenantResource{
+		{Key: "My App", ID: "client-1"},
+		{Key: "Other App", ID: "client-2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "client-2", byKey["Other App"].ID)
+
+	_, err = tenantResourcesByKey(kind, []tenantResource{
+		{Key: "My App", ID: "client-1"},
+		{Key: "My App", ID: "client-2"},
+	})
+	assert.EqualError(t, err, `"client-1" and "client-2" both have the name "My App", rename one of them so that they can be told apart`)
+}
+
+func TestPlanTenantChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roleAPI := mock.NewMockRoleAPI(ctrl)
+	roleAPI.EXPECT().List(gomock.Any(), gomock.Any()).Return(&management.RoleList{
+		Roles: []*management.Role{
+			{ID: auth0.String("rol_1"), Name: auth0.String("Admin"), Description: auth0.String("Administrators")},
+			{ID: auth0.String("rol_2"), Name: auth0.String("Editor"), Description: auth0.String("Editors")},
+			{ID: auth0.String("rol_3"), Name: auth0.String("Viewer"), Description: auth0.String("Viewers")},
+		},
+	}, nil)
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "roles"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "roles", "admin.yaml"), []byte("name: Admin\ndescription: Administrators\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "roles", "editor.yaml"), []byte("name: Editor\ndescription: Content editors\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "roles", "support.json"), []byte(`{"name":"Support"}`), 0600))
+
+	// Clients have no directory in the configuration, so they must be left untouched.
+	kinds := []tenantResourceKind{&roleTenantResourceKind{&auth0.API{Role: roleAPI}}, &clientTenantResourceKind{}}
+
+	changes, err := planTenantChanges(context.Background(), dir, kinds, "")
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, tenantChangeUpdate, changes[0].Action)
+	assert.Equal(t, "Editor", changes[0].Key)
+	assert.Equal(t, "rol_2", changes[0].id)
+
+	assert.Equal(t, tenantChangeCreate, changes[1].Action)
+	assert.Equal(t, "Support", changes[1].Key)
+
+	assert.Equal(t, tenantChangeDelete, changes[2].Action)
+	assert.Equal(t, "Viewer", changes[2].Key)
+	assert.Equal(t, "rol_3", changes[2].id)
+
+	kept, skipped := withoutTenantDeletes(changes)
+	assert.Equal(t, changes[:2], kept)
+	assert.Equal(t, 1, skipped)
+}
+
+func TestPlanTenantChangesKeepsProtectedClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientAPI := mock.NewMockClientAPI(ctrl)
+	clientAPI.EXPECT().List(gomock.Any(), gomock.Any()).Return(&management.ClientList{
+		Clients: []*management.Client{
+			{ClientID: auth0.String("cli-client-id"), Name: auth0.String("Auth0 CLI")},
+			{ClientID: auth0.String("other-client-id"), Name: auth0.String("Legacy App")},
+		},
+	}, nil)
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "clients"), 0755))
+
+	kinds := []tenantResourceKind{&clientTenantResourceKind{&auth0.API{Client: clientAPI}}}
+
+	changes, err := planTenantChanges(context.Background(), dir, kinds, "cli-client-id")
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+
+	assert.Equal(t, tenantChangeDelete, changes[0].Action)
+	assert.Equal(t, "Legacy App", changes[0].Key)
+}
+
+func TestApplyTenantChangesStopsAtFirstFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roleAPI := mock.NewMockRoleAPI(ctrl)
+	gomock.InOrder(
+		roleAPI.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+		roleAPI.EXPECT().Create(gomock.Any(), gomock.Any()).Return(testManagementError{message: "Bad Request", status: 400}),
+	)
+
+	// The client depending on the role that failed to be created must not be applied.
+	roleKind := &roleTenantResourceKind{&auth0.API{Role: roleAPI}}
+	clientKind := &clientTenantResourceKind{&auth0.API{Client: mock.NewMockClientAPI(ctrl)}}
+	changes := []tenantChange{
+		newTenantChange(tenantChangeCreate, roleKind, "", "Admin", map[string]interface{}{"name": "Admin"}),
+		newTenantChange(tenantChangeCreate, roleKind, "", "Editor", map[string]interface{}{"name": "Editor"}),
+		newTenantChange(tenantChangeCreate, clientKind, "", "My App", map[string]interface{}{"name": "My App"}),
+	}
+
+	applied, err := applyTenantChanges(context.Background(), changes)
+	assert.Equal(t, 1, applied)
+	assert.ErrorContains(t, err, `failed to create roles "Editor"`)
+}
+
+func TestConnectionTenantResourceKindSecrets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	connection := &management.Connection{
+		ID:       auth0.String("con_1"),
+		Name:     auth0.String("google-oauth2"),
+		Strategy: auth0.String("google-oauth2"),
+		Options: &management.ConnectionOptionsGoogleOAuth2{
+			ClientID:     auth0.String("some-client-id"),
+			ClientSecret: auth0.String("some-client-secret"),
+			Email:        auth0.Bool(true),
+		},
+	}
+
+	clientAPI := mock.NewMockClientAPI(ctrl)
+	clientAPI.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(&management.ClientList{
+		Clients: []*management.Client{
+			{ClientID: auth0.String("client-1"), Name: auth0.String("My App")},
+			{ClientID: auth0.String("client-2"), Name: auth0.String("Other App")},
+		},
+	}, nil).Times(2)
+
+	connectionAPI := mock.NewMockConnectionAPI(ctrl)
+	connectionAPI.EXPECT().List(gomock.Any(), gomock.Any()).Return(&management.ConnectionList{
+		Connections: []*management.Connection{connection},
+	}, nil)
+	connectionAPI.EXPECT().
+		ReadEnabledClients(gomock.Any(), "con_1", gomock.Any()).
+		Return(&management.ConnectionEnabledClientList{
+			Clients: &[]management.ConnectionEnabledClient{{ClientID: auth0.String("client-1")}},
+		}, nil).
+		Times(2)
+	connectionAPI.EXPECT().Read(gomock.Any(), "con_1").Return(connection, nil)
+	connectionAPI.EXPECT().
+		Update(gomock.Any(), "con_1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, c *management.Connection, _ ...management.RequestOption) error {
+			options, ok := c.Options.(*management.ConnectionOptionsGoogleOAuth2)
+			require.True(t, ok)
+			assert.Equal(t, "some-client-secret", options.GetClientSecret())
+			assert.False(t, options.GetEmail())
+			return nil
+		})
+	connectionAPI.EXPECT().UpdateEnabledClients(gomock.Any(), "con_1", []management.ConnectionEnabledClient{
+		{ClientID: auth0.String("client-2"), Status: auth0.Bool(true)},
+		{ClientID: auth0.String("client-1"), Status: auth0.Bool(false)},
+	})
+
+	kind := &connectionTenantResourceKind{&auth0.API{Client: clientAPI, Connection: connectionAPI}}
+
+	resources, err := kind.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+
+	options := resources[0].Data["options"].(map[string]interface{})
+	assert.Equal(t, "some-client-id", options["client_id"])
+	assert.NotContains(t, options, "client_secret")
+	assert.Equal(t, []interface{}{"My App"}, resources[0].Data["enabled_clients"])
+
+	options["email"] = false
+	resources[0].Data["enabled_clients"] = []interface{}{"Other App"}
+	require.NoError(t, kind.Update(context.Background(), "con_1", resources[0].Data))
+}
+
+func TestConnectionTenantResourceKindRejectsUnknownEnabledClients(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientAPI := mock.NewMockClientAPI(ctrl)
+	clientAPI.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(&management.ClientList{
+		Clients: []*management.Client{
+			{ClientID: auth0.String("client-1"), Name: auth0.String("My App")},
+			{ClientID: auth0.String("client-2"), Name: auth0.String("My App")},
+		},
+	}, nil).Times(2)
+
+	connectionAPI := mock.NewMockConnectionAPI(ctrl)
+	connectionAPI.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	kind := &connectionTenantResourceKind{&auth0.API{Client: clientAPI, Connection: connectionAPI}}
+
+	err := kind.Create(context.Background(), map[string]interface{}{
+		"name":            "Username-Password-Authentication",
+		"strategy":        "auth0",
+		"enabled_clients": []interface{}{"Missing App"},
+	})
+	assert.EqualError(t, err, `failed to find enabled client "Missing App"`)
+
+	err = kind.Create(context.Background(), map[string]interface{}{
+		"name":            "Username-Password-Authentication",
+		"strategy":        "auth0",
+		"enabled_clients": []interface{}{"My App"},
+	})
+	assert.EqualError(t, err, `found 2 clients named "My App", rename them so that the enabled client can be told apart`)
+}
+
+func TestActionTenantResourceKindDeploysActions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	defer func(interval time.Duration) { actionBuildPollInterval = interval }(actionBuildPollInterval)
+	actionBuildPollInterval = time.Millisecond
+
+	actionAPI := mock.NewMockActionAPI(ctrl)
+	gomock.InOrder(
+		actionAPI.EXPECT().Update(gomock.Any(), "act_1", gomock.Any()).Return(nil),
+		actionAPI.EXPECT().Read(gomock.Any(), "act_1").Return(&management.Action{Status: auth0.String("building")}, nil),
+		actionAPI.EXPECT().Read(gomock.Any(), "act_1").Return(&management.Action{Status: auth0.String("built")}, nil),
+		actionAPI.EXPECT().Deploy(gomock.Any(), "act_1").Return(&management.ActionVersion{}, nil),
+	)
+
+	kind := &actionTenantResourceKind{&auth0.API{Action: actionAPI}}
+	require.NoError(t, kind.Update(context.Background(), "act_1", map[string]interface{}{"name": "Log in"}))
+
+	t.Run("it fails when the action fails to build", func(t *testing.T) {
+		actionAPI.EXPECT().Update(gomock.Any(), "act_1", gomock.Any()).Return(nil)
+		actionAPI.EXPECT().Read(gomock.Any(), "act_1").Return(&management.Action{Status: auth0.String("failed")}, nil)
+
+		err := kind.Update(context.Background(), "act_1", map[string]interface{}{"name": "Log in"})
+		assert.EqualError(t, err, `failed to build action with ID "act_1"`)
+	})
+
+	t.Run("it stops waiting for a build that doesn't complete", func(t *testing.T) {
+		defer func(timeout time.Duration) { actionBuildTimeout = timeout }(actionBuildTimeout)
+		actionBuildTimeout = 10 * time.Millisecond
+
+		actionAPI.EXPECT().Update(gomock.Any(), "act_1", gomock.Any()).Return(nil)
+		actionAPI.EXPECT().Read(gomock.Any(), "act_1").Return(&management.Action{Status: auth0.String("pending")}, nil).MinTimes(1)
+
+		err := kind.Update(context.Background(), "act_1", map[string]interface{}{"name": "Log in"})
+		assert.EqualError(t, err, `the action with ID "act_1" was not built within 10ms`)
+	})
+
+	t.Run("it stops waiting when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		actionAPI.EXPECT().Update(gomock.Any(), "act_1", gomock.Any()).Return(nil)
+		actionAPI.EXPECT().Read(gomock.Any(), "act_1").Return(&management.Action{Status: auth0.String("pending")}, nil).MaxTimes(1)
+
+		err := kind.Update(ctx, "act_1", map[string]interface{}{"name": "Log in"})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestIsConnectionSecretOption(t *testing.T) {
+	for _, option := range []string{"client_secret", "signing_key", "configuration", "api_key", "accessToken", "bind_password"} {
+		assert.True(t, isConnectionSecretOption(option), option)
+	}
+	for _, option := range []string{"client_id", "scope", "domain", "upstream_params"} {
+		assert.False(t, isConnectionSecretOption(option), option)
+	}
+}
@@ -0,0 +1,244 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const (
+	tenantDiffAdded   = "added"
+	tenantDiffRemoved = "removed"
+	tenantDiffChanged = "changed"
+)
+
+var (
+	tenantDiffFrom = Flag{
+		Name:       "From",
+		LongForm:   "from",
+		Help:       "Tenant to compare from.",
+		IsRequired: true,
+	}
+
+	tenantDiffTo = Flag{
+		Name:       "To",
+		LongForm:   "to",
+		Help:       "Tenant to compare to.",
+		IsRequired: true,
+	}
+)
+
+func diffTenantCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		From      string
+		To        string
+		Resources []string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Args:  cobra.NoArgs,
+		Short: "Compare the configuration of two tenants",
+		Long: "Compare the configuration of two tenants you are logged in to, such as before promoting changes " +
+			"from a development tenant to a production one.\n\n" +
+			"Resources are matched by name, or by identifier for resource servers, as IDs differ from one tenant " +
+			"to another, so resources sharing a name fail the comparison. " +
+			"Read-only fields such as IDs, secrets and timestamps are left out of the comparison.",
+		Example: `  auth0 tenants diff --from dev.auth0.com --to prod.auth0.com
+  auth0 tenants diff --from dev.auth0.com --to prod.auth0.com --resources clients,actions,roles
+  auth0 tenants diff --from dev.auth0.com --to prod.auth0.com --json
+  auth0 tenants diff --from dev.auth0.com --to prod.auth0.com --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Replayed responses don't need the tenants to be logged in to.
+			if cli.replay == "" {
+				if err := cli.Config.Validate(); err != nil {
+					return err
+				}
+			}
+
+			fromAPI, err := cli.tenantAPI(cmd.Context(), inputs.From)
+			if err != nil {
+				return err
+			}
+
+			toAPI, err := cli.tenantAPI(cmd.Context(), inputs.To)
+			if err != nil {
+				return err
+			}
+
+			diffs, err := diffTenants(cmd.Context(), inputs.From, inputs.To, fromAPI, toAPI, inputs.Resources)
+			if err != nil {
+				return err
+			}
+
+			cli.renderTenantDiff(inputs.From, inputs.To, diffs)
+
+			return nil
+		},
+	}
+
+	tenantDiffFrom.RegisterString(cmd, &inputs.From, "")
+	tenantDiffTo.RegisterString(cmd, &inputs.To, "")
+	tenantConfigResources.RegisterStringSlice(cmd, &inputs.Resources, nil)
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+// diffTenants compares the resources of both tenants, one kind of resources at a time.
+func diffTenants(
+	ctx context.Context,
+	from, to string,
+	fromAPI, toAPI *auth0.API,
+	resources []string,
+) ([]display.TenantResourceDiff, error) {
+	fromKinds, err := filterTenantResourceKinds(tenantResourceKinds(fromAPI), resources)
+	if err != nil {
+		return nil, err
+	}
+
+	toKinds, err := filterTenantResourceKinds(tenantResourceKinds(toAPI), resources)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := []display.TenantResourceDiff{}
+	for i := range fromKinds {
+		var fromResources, toResources []tenantResource
+		if err := ansi.Spinner("Comparing "+fromKinds[i].Name(), func() (err error) {
+			if fromResources, err = fromKinds[i].List(ctx); err != nil {
+				return fmt.Errorf("failed to list %s of tenant %q: %w", fromKinds[i].Name(), from, err)
+			}
+			if toResources, err = toKinds[i].List(ctx); err != nil {
+				return fmt.Errorf("failed to list %s of tenant %q: %w", toKinds[i].Name(), to, err)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		kindDiffs, err := diffTenantResources(fromKinds[i], fromResources, toResources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare %s of tenants %q and %q: %w", fromKinds[i].Name(), from, to, err)
+		}
+
+		diffs = append(diffs, kindDiffs...)
+	}
+
+	return diffs, nil
+}
+
+func (c *cli) renderTenantDiff(from, to string, diffs []display.TenantResourceDiff) {
+	switch {
+	case c.json:
+		c.renderer.JSONResult(diffs)
+	case c.jsonCompact:
+		c.renderer.JSONCompactResult(diffs)
+	default:
+		printTenantDiff(os.Stdout, from, to, diffs)
+	}
+}
+
+// diffTenantResources matches the resources of both tenants by key and returns
+// the ones that differ, sorted by key. Resources sharing a key in either tenant
+// can't be matched, so they fail the comparison.
+func diffTenantResources(kind tenantResourceKind, from, to []tenantResource) ([]display.TenantResourceDiff, error) {
+	resource := kind.Name()
+
+	fromByKey, err := tenantResourcesByKey(kind, from)
+	if err != nil {
+		return nil, err
+	}
+
+	toByKey, err := tenantResourcesByKey(kind, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []display.TenantResourceDiff
+	for key, fromResource := range fromByKey {
+		toResource, ok := toByKey[key]
+		switch {
+		case !ok:
+			diffs = append(diffs, display.TenantResourceDiff{
+				Resource: resource, Key: key, Status: tenantDiffRemoved, From: fromResource.Data,
+			})
+		case formatTenantResourceData(fromResource.Data) != formatTenantResourceData(toResource.Data):
+			diffs = append(diffs, display.TenantResourceDiff{
+				Resource: resource, Key: key, Status: tenantDiffChanged, From: fromResource.Data, To: toResource.Data,
+			})
+		}
+	}
+
+	for key, toResource := range toByKey {
+		if _, ok := fromByKey[key]; !ok {
+			diffs = append(diffs, display.TenantResourceDiff{
+				Resource: resource, Key: key, Status: tenantDiffAdded, To: toResource.Data,
+			})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Key < diffs[j].Key
+	})
+
+	return diffs, nil
+}
+
+// formatTenantResourceData formats the data as YAML, which sorts the fields
+// and keeps the code of actions readable, so that it can be compared line by line.
+func formatTenantResourceData(data map[string]interface{}) string {
+	formatted, err := yaml.Marshal(data)
+	if err != nil {
+		return fmt.Sprintf("%v\n", data)
+	}
+
+	return string(formatted)
+}
+
+func printTenantDiff(w io.Writer, from, to string, diffs []display.TenantResourceDiff) {
+	if len(diffs) == 0 {
+		fmt.Fprintf(w, "No differences found between %s and %s\n", from, to)
+		return
+	}
+
+	fmt.Fprintf(w, "Comparing %s → %s:\n", from, to)
+
+	for _, diff := range diffs {
+		path := diff.Resource + "/" + diff.Key
+
+		text, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        tenantResourceDiffLines(diff.From),
+			B:        tenantResourceDiffLines(diff.To),
+			FromFile: from + "/" + path,
+			ToFile:   to + "/" + path,
+			Context:  3,
+		})
+
+		fmt.Fprintf(w, "\n%s (%s)\n", ansi.Bold(path), diff.Status)
+		printColoredDiffLines(w, text)
+	}
+}
+
+func tenantResourceDiffLines(data map[string]interface{}) []string {
+	if data == nil {
+		return nil
+	}
+
+	// The formatted data ends with a line ending, so the last element is empty.
+	lines := strings.SplitAfter(formatTenantResourceData(data), "\n")
+	return lines[:len(lines)-1]
+}
@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/har"
+)
+
+func TestDiffTenantResources(t *testing.T) {
+	from := []tenantResource{
+		{ID: "rol_1", Key: "Admin", Data: map[string]interface{}{"name": "Admin", "description": "Administrators"}},
+		{ID: "rol_2", Key: "Editor", Data: map[string]interface{}{"name": "Editor", "description": "Editors"}},
+		{ID: "rol_3", Key: "Viewer", Data: map[string]interface{}{"name": "Viewer"}},
+	}
+	to := []tenantResource{
+		{ID: "rol_a", Key: "Admin", Data: map[string]interface{}{"description": "Administrators", "name": "Admin"}},
+		{ID: "rol_b", Key: "Editor", Data: map[string]interface{}{"name": "Editor", "description": "Content editors"}},
+		{ID: "rol_c", Key: "Support", Data: map[string]interface{}{"name": "Support"}},
+	}
+
+	diffs, err := diffTenantResources(&roleTenantResourceKind{}, from, to)
+	require.NoError(t, err)
+	require.Len(t, diffs, 3)
+
+	assert.Equal(t, "Editor", diffs[0].Key)
+	assert.Equal(t, tenantDiffChanged, diffs[0].Status)
+	assert.Equal(t, "Content editors", diffs[0].To["description"])
+
+	assert.Equal(t, "Support", diffs[1].Key)
+	assert.Equal(t, tenantDiffAdded, diffs[1].Status)
+	assert.Nil(t, diffs[1].From)
+
+	assert.Equal(t, "Viewer", diffs[2].Key)
+	assert.Equal(t, tenantDiffRemoved, diffs[2].Status)
+	assert.Nil(t, diffs[2].To)
+}
+
+func TestDiffTenantResourcesRejectsDuplicateKeys(t *testing.T) {
+	from := []tenantResource{
+		{ID: "rol_1", Key: "Admin", Data: map[string]interface{}{"name": "Admin"}},
+	}
+	to := []tenantResource{
+		{ID: "rol_a", Key: "Admin", Data: map[string]interface{}{"name": "Admin"}},
+		{ID: "rol_b", Key: "Admin", Data: map[string]interface{}{"name": "Admin", "description": "Administrators"}},
+	}
+
+	_, err := diffTenantResources(&roleTenantResourceKind{}, from, to)
+	assert.EqualError(t, err, `"rol_a" and "rol_b" both have the name "Admin", rename one of them so that they can be told apart`)
+}
+
+func TestDiffTenants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	code := "exports.onExecutePostLogin = async () => {};"
+
+	// The clients aren't compared, so their API is left unset and would panic if used.
+	fromRoleAPI := mock.NewMockRoleAPI(ctrl)
+	fromRoleAPI.EXPECT().List(gomock.Any(), gomock.Any()).Return(&management.RoleList{
+		Roles: []*management.Role{{ID: auth0.String("rol_1"), Name: auth0.String("Admin"), Description: auth0.String("Admins")}},
+	}, nil)
+	fromActionAPI := mock.NewMockActionAPI(ctrl)
+	fromActionAPI.EXPECT().List(gomock.Any(), gomock.Any()).Return(&management.ActionList{
+		Actions: []*management.Action{
+			{
+				ID:        auth0.String("act_1"),
+				Name:      auth0.String("Log in"),
+				Code:      &code,
+				Status:    auth0.String("built"),
+				CreatedAt: auth0.Time(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt: auth0.Time(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+	}, nil)
+
+	toRoleAPI := mock.NewMockRoleAPI(ctrl)
+	toRoleAPI.EXPECT().List(gomock.Any(), gomock.Any()).Return(&management.RoleList{
+		Roles: []*management.Role{{ID: auth0.String("rol_a"), Name: auth0.String("Admin"), Description: auth0.String("Administrators")}},
+	}, nil)
+	toActionAPI := mock.NewMockActionAPI(ctrl)
+	toActionAPI.EXPECT().List(gomock.Any(), gomock.Any()).Return(&management.ActionList{
+		Actions: []*management.Action{
+			{
+				ID:        auth0.String("act_a"),
+				Name:      auth0.String("Log in"),
+				Code:      &code,
+				Status:    auth0.String("pending"),
+				CreatedAt: auth0.Time(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt: auth0.Time(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+	}, nil)
+
+	diffs, err := diffTenants(
+		context.Background(),
+		"dev.auth0.com",
+		"prod.auth0.com",
+		&auth0.API{Role: fromRoleAPI, Action: fromActionAPI},
+		&auth0.API{Role: toRoleAPI, Action: toActionAPI},
+		[]string{"actions", "roles"},
+	)
+	require.NoError(t, err)
+
+	// The actions only differ by their IDs, statuses and timestamps, so they match.
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "roles", diffs[0].Resource)
+	assert.Equal(t, "Admin", diffs[0].Key)
+	assert.Equal(t, tenantDiffChanged, diffs[0].Status)
+
+	_, err = diffTenants(context.Background(), "dev.auth0.com", "prod.auth0.com", &auth0.API{}, &auth0.API{}, []string{"rules"})
+	assert.ErrorContains(t, err, `unsupported resource "rules"`)
+}
+
+func TestRenderTenantDiff(t *testing.T) {
+	diffs := []display.TenantResourceDiff{
+		{
+			Resource: "roles",
+			Key:      "Admin",
+			Status:   tenantDiffChanged,
+			From:     map[string]interface{}{"name": "Admin", "description": "Admins"},
+			To:       map[string]interface{}{"name": "Admin", "description": "Administrators"},
+		},
+		{
+			Resource: "roles",
+			Key:      "Support",
+			Status:   tenantDiffAdded,
+			To:       map[string]interface{}{"name": "Support"},
+		},
+	}
+
+	t.Run("it renders the differences in json", func(t *testing.T) {
+		result := &bytes.Buffer{}
+		cli := &cli{
+			json:     true,
+			renderer: &display.Renderer{MessageWriter: &bytes.Buffer{}, ResultWriter: result},
+		}
+
+		cli.renderTenantDiff("dev.auth0.com", "prod.auth0.com", diffs)
+
+		assert.JSONEq(t, `[
+			{
+				"resource": "roles",
+				"key": "Admin",
+				"status": "changed",
+				"from": {"name": "Admin", "description": "Admins"},
+				"to": {"name": "Admin", "description": "Administrators"}
+			},
+			{
+				"resource": "roles",
+				"key": "Support",
+				"status": "added",
+				"to": {"name": "Support"}
+			}
+		]`, result.String())
+	})
+
+	t.Run("it renders no differences as an empty json array", func(t *testing.T) {
+		result := &bytes.Buffer{}
+		cli := &cli{
+			jsonCompact: true,
+			renderer:    &display.Renderer{MessageWriter: &bytes.Buffer{}, ResultWriter: result},
+		}
+
+		cli.renderTenantDiff("dev.auth0.com", "prod.auth0.com", []display.TenantResourceDiff{})
+
+		assert.Equal(t, "[]", result.String())
+	})
+}
+
+func TestPrintTenantDiff(t *testing.T) {
+	output := &bytes.Buffer{}
+	printTenantDiff(output, "dev.auth0.com", "prod.auth0.com", []display.TenantResourceDiff{
+		{
+			Resource: "roles",
+			Key:      "Admin",
+			Status:   tenantDiffChanged,
+			From:     map[string]interface{}{"name": "Admin", "description": "Admins"},
+			To:       map[string]interface{}{"name": "Admin", "description": "Administrators"},
+		},
+	})
+
+	// Unchanged lines keep their single line ending and no blank line follows the diff.
+	assert.Equal(t, "Comparing dev.auth0.com → prod.auth0.com:\n"+
+		"\n"+
+		"roles/Admin (changed)\n"+
+		"--- dev.auth0.com/roles/Admin\n"+
+		"+++ prod.auth0.com/roles/Admin\n"+
+		"@@ -1,2 +1,2 @@\n"+
+		"-description: Admins\n"+
+		"+description: Administrators\n"+
+		" name: Admin\n", output.String())
+}
+
+func TestDiffTenantCmdWithReplay(t *testing.T) {
+	// No tenant is logged in, as the responses are served from the recording.
+	t.Setenv("HOME", t.TempDir())
+
+	roles := func(host, description string) har.Entry {
+		return har.Entry{
+			Request: har.Request{Method: http.MethodGet, URL: "https://" + host + "/api/v2/roles?include_totals=true&page=0&per_page=50"},
+			Response: har.Response{
+				Status:  http.StatusOK,
+				Headers: []har.NameValue{{Name: "Content-Type", Value: "application/json"}},
+				Content: har.Content{Text: `{"roles": [{"id": "rol_1", "name": "Admin", "description": "` + description + `"}], "start": 0, "limit": 50, "total": 1}`},
+			},
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "recording.har")
+	require.NoError(t, har.Write(path, &har.HAR{Log: har.Log{Entries: []har.Entry{
+		roles("dev.auth0.com", "Administrators"),
+		roles("prod.auth0.com", "Admins"),
+	}}}))
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+		replay:   path,
+	}
+
+	cmd := diffTenantCmd(cli)
+	cmd.SetArgs([]string{"--from", "dev.auth0.com", "--to", "prod.auth0.com", "--resources", "roles", "--json"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, buf.String(), `"Admins"`)
+}
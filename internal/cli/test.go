@@ -129,6 +129,9 @@ func testLoginCmd(cli *cli) *cobra.Command {
   auth0 test login <client-id> -c <connection-name> -a <api-identifier> -d <domain> -o <org-id> -s <scope1,scope2> -p "foo=bar" -p "bazz=buzz" --json
   auth0 test login <client-id> -c <connection-name> -a <api-identifier> -d <domain> -o <org-id> -s <scope1,scope2> -p "foo=bar","bazz=buzz" --json
   auth0 test login <client-id> -c <connection-name> -a <api-identifier> -d <domain> -s <scope1,scope2> --force --json`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return cli.applyProfileAudience(cmd, &testAudience)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := selectClientToUseForTestsAndValidateExistence(cli, cmd, args, &inputs)
 			if err != nil {
@@ -222,6 +225,9 @@ func testTokenCmd(cli *cli) *cobra.Command {
   auth0 test token <client-id> -a <api-identifier> -s <scope1,scope2> --json-compact
   auth0 test token <client-id> -a <api-identifier> -o <org-id> -s <scope1,scope2> -p "foo=bar","bazz=buzz" --json
   auth0 test token <client-id> -a <api-identifier> -s <scope1,scope2> --force --json`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return cli.applyProfileAudience(cmd, &testAudienceRequired)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var tokenResponse *authutil.TokenResponse
 
@@ -329,6 +335,10 @@ func testTokenCmd(cli *cli) *cobra.Command {
 }
 
 func selectClientToUseForTestsAndValidateExistence(cli *cli, cmd *cobra.Command, args []string, inputs *testCmdInputs) (*management.Client, error) {
+	if len(args) == 0 && cli.activeProfile.DefaultAppID != "" {
+		args = []string{cli.activeProfile.DefaultAppID}
+	}
+
 	if len(args) == 0 {
 		if err := testClientID.Pick(cmd, &inputs.ClientID, cli.appPickerWithCreateOption); err != nil {
 			return nil, err
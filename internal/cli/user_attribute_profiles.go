@@ -0,0 +1,550 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var userAttributeProfileStrategies = []string{"ad", "adfs", "google-apps", "oidc", "okta", "pingfederate", "samlp", "waad"}
+
+const defaultUserAttributeProfileMapping = `{
+    "user_id": {
+        "oidc_mapping": "sub",
+        "saml_mapping": [
+            "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/nameidentifier"
+        ],
+        "scim_mapping": "externalId"
+    },
+    "user_attributes": {
+        "email": {
+            "description": "Email of the user",
+            "label": "Email",
+            "profile_required": true,
+            "auth0_mapping": "email",
+            "oidc_mapping": {
+                "mapping": "email"
+            },
+            "saml_mapping": [
+                "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"
+            ],
+            "scim_mapping": "emails[primary eq true].value"
+        }
+    }
+}`
+
+var (
+	userAttributeProfileID = Argument{
+		Name: "Profile ID",
+		Help: "Id of the user attribute profile.",
+	}
+
+	userAttributeProfileName = Flag{
+		Name:       "Name",
+		LongForm:   "name",
+		ShortForm:  "n",
+		Help:       "Name of the user attribute profile.",
+		IsRequired: true,
+	}
+
+	userAttributeProfileMappingFlag = Flag{
+		Name:      "Mapping",
+		LongForm:  "mapping",
+		ShortForm: "m",
+		Help: "Attribute mappings of the profile, formatted as JSON with the \"user_id\" and \"user_attributes\" keys. " +
+			"When omitted, the mappings are opened in your default editor.",
+		IsRequired: true,
+	}
+
+	userAttributeProfileNumber = Flag{
+		Name:      "Number",
+		LongForm:  "number",
+		ShortForm: "n",
+		Help:      "Number of user attribute profiles to retrieve. Minimum 1, maximum 1000.",
+	}
+)
+
+// userAttributeProfileMapping holds the attribute mappings of a user attribute
+// profile, which are edited as a single JSON document.
+type userAttributeProfileMapping struct {
+	UserID         *management.UserAttributeProfileUserID                    `json:"user_id,omitempty"`
+	UserAttributes map[string]*management.UserAttributeProfileUserAttributes `json:"user_attributes"`
+}
+
+func userAttributeProfilesCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "user-attribute-profiles",
+		Aliases: []string{"uap"},
+		Short:   "Manage resources for user attribute profiles",
+		Long: "User attribute profiles define how the user attributes of enterprise connections, " +
+			"such as the ones created through self-service SSO, map to the Auth0 user profile.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listUserAttributeProfilesCmd(cli))
+	cmd.AddCommand(showUserAttributeProfileCmd(cli))
+	cmd.AddCommand(createUserAttributeProfileCmd(cli))
+	cmd.AddCommand(updateUserAttributeProfileCmd(cli))
+	cmd.AddCommand(deleteUserAttributeProfileCmd(cli))
+
+	return cmd
+}
+
+func listUserAttributeProfilesCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Number int
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List your user attribute profiles",
+		Long:    "List your existing user attribute profiles. To create one, run: `auth0 user-attribute-profiles create`.",
+		Example: `  auth0 user-attribute-profiles list
+  auth0 user-attribute-profiles ls
+  auth0 user-attribute-profiles ls --number 100
+  auth0 user-attribute-profiles ls -n 100 --json
+  auth0 user-attribute-profiles ls -n 100 --json-compact
+  auth0 user-attribute-profiles ls --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Number < 1 || inputs.Number > 1000 {
+				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
+			}
+
+			var profiles []*management.UserAttributeProfile
+			if err := ansi.Waiting(func() (err error) {
+				profiles, err = cli.getUserAttributeProfiles(cmd.Context(), inputs.Number)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to list user attribute profiles: %w", err)
+			}
+
+			cli.renderer.UserAttributeProfileList(profiles)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	userAttributeProfileNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+
+	return cmd
+}
+
+func showUserAttributeProfileCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show a user attribute profile",
+		Long:  "Display information about a user attribute profile.",
+		Example: `  auth0 user-attribute-profiles show
+  auth0 user-attribute-profiles show <profile-id>
+  auth0 user-attribute-profiles show <profile-id> --json
+  auth0 user-attribute-profiles show <profile-id> --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := userAttributeProfileID.Pick(cmd, &inputs.ID, cli.userAttributeProfilePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var profile *management.UserAttributeProfile
+			if err := ansi.Waiting(func() (err error) {
+				profile, err = cli.api.UserAttributeProfile.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read user attribute profile with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.UserAttributeProfileShow(profile)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func createUserAttributeProfileCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Name    string
+		Mapping string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Args:  cobra.NoArgs,
+		Short: "Create a new user attribute profile",
+		Long: "Create a new user attribute profile.\n\n" +
+			"To create interactively, use `auth0 user-attribute-profiles create` with no flags. " +
+			"The attribute mappings will be opened as JSON in your default editor.\n\n" +
+			"To create non-interactively, supply the name and the attribute mappings through the flags. " +
+			"The mappings are validated before the profile is created.",
+		Example: `  auth0 user-attribute-profiles create
+  auth0 user-attribute-profiles create --name "Enterprise Attributes"
+  auth0 user-attribute-profiles create -n "Enterprise Attributes" --mapping "$(cat mapping.json)"
+  auth0 user-attribute-profiles create -n "Enterprise Attributes" -m "$(cat mapping.json)" --json
+  auth0 user-attribute-profiles create -n "Enterprise Attributes" -m "$(cat mapping.json)" --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := userAttributeProfileName.Ask(cmd, &inputs.Name, nil); err != nil {
+				return err
+			}
+
+			if err := userAttributeProfileMappingFlag.OpenEditor(
+				cmd,
+				&inputs.Mapping,
+				defaultUserAttributeProfileMapping,
+				"user-attribute-profile.*.json",
+				cli.userAttributeProfileEditorHint,
+			); err != nil {
+				return fmt.Errorf("failed to capture input from the editor: %w", err)
+			}
+
+			mapping, err := parseUserAttributeProfileMapping(inputs.Mapping)
+			if err != nil {
+				return err
+			}
+
+			profile := &management.UserAttributeProfile{
+				Name:           &inputs.Name,
+				UserID:         mapping.UserID,
+				UserAttributes: mapping.UserAttributes,
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.UserAttributeProfile.Create(cmd.Context(), profile)
+			}); err != nil {
+				return fmt.Errorf("failed to create user attribute profile: %w", err)
+			}
+
+			cli.renderer.UserAttributeProfileCreate(profile)
+			return nil
+		},
+	}
+
+	userAttributeProfileName.RegisterString(cmd, &inputs.Name, "")
+	userAttributeProfileMappingFlag.RegisterString(cmd, &inputs.Mapping, "")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func updateUserAttributeProfileCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID      string
+		Name    string
+		Mapping string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Update a user attribute profile",
+		Long: "Update a user attribute profile.\n\n" +
+			"To update interactively, use `auth0 user-attribute-profiles update` with no arguments. " +
+			"The current attribute mappings will be opened as JSON in your default editor.\n\n" +
+			"To update non-interactively, supply the profile id and the settings to change through the flags. " +
+			"Mappings passed through `--mapping` replace the existing ones and are validated before the profile is updated.",
+		Example: `  auth0 user-attribute-profiles update
+  auth0 user-attribute-profiles update <profile-id>
+  auth0 user-attribute-profiles update <profile-id> --name "Enterprise Attributes"
+  auth0 user-attribute-profiles update <profile-id> --mapping "$(cat mapping.json)"
+  auth0 user-attribute-profiles update <profile-id> -n "Enterprise Attributes" -m "$(cat mapping.json)" --json
+  auth0 user-attribute-profiles update <profile-id> -n "Enterprise Attributes" -m "$(cat mapping.json)" --json-compact`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := userAttributeProfileID.Pick(cmd, &inputs.ID, cli.userAttributeProfilePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var current *management.UserAttributeProfile
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.api.UserAttributeProfile.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read user attribute profile with ID %q: %w", inputs.ID, err)
+			}
+
+			currentMapping, err := json.MarshalIndent(&userAttributeProfileMapping{
+				UserID:         current.UserID,
+				UserAttributes: current.UserAttributes,
+			}, "", "    ")
+			if err != nil {
+				return fmt.Errorf("failed to serialize the mappings of user attribute profile with ID %q: %w", inputs.ID, err)
+			}
+
+			if noLocalFlagSet(cmd) {
+				if err := userAttributeProfileName.AskU(cmd, &inputs.Name, current.Name); err != nil {
+					return err
+				}
+
+				if err := userAttributeProfileMappingFlag.OpenEditorU(
+					cmd,
+					&inputs.Mapping,
+					string(currentMapping),
+					"user-attribute-profile.*.json",
+				); err != nil {
+					return fmt.Errorf("failed to capture input from the editor: %w", err)
+				}
+			}
+
+			update := &management.UserAttributeProfile{
+				// The user ID mapping is reset to its defaults when omitted, so always send it.
+				UserID: current.UserID,
+			}
+			if inputs.Name != "" {
+				update.Name = &inputs.Name
+			}
+			if inputs.Mapping != "" && inputs.Mapping != string(currentMapping) {
+				mapping, err := parseUserAttributeProfileMapping(inputs.Mapping)
+				if err != nil {
+					return err
+				}
+
+				update.UserID = mapping.UserID
+				update.UserAttributes = mapping.UserAttributes
+			}
+
+			var updated *management.UserAttributeProfile
+			if err := ansi.Waiting(func() (err error) {
+				if err = cli.api.UserAttributeProfile.Update(cmd.Context(), inputs.ID, update); err != nil {
+					return err
+				}
+				updated, err = cli.api.UserAttributeProfile.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to update user attribute profile with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.UserAttributeProfileUpdate(updated)
+			return nil
+		},
+	}
+
+	userAttributeProfileName.RegisterStringU(cmd, &inputs.Name, "")
+	userAttributeProfileMappingFlag.RegisterStringU(cmd, &inputs.Mapping, "")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func deleteUserAttributeProfileCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a user attribute profile",
+		Long: "Delete a user attribute profile.\n\n" +
+			"To delete interactively, use `auth0 user-attribute-profiles delete` with no arguments.\n\n" +
+			"To delete non-interactively, supply the profile id and the `--force` flag to skip confirmation.",
+		Example: `  auth0 user-attribute-profiles delete
+  auth0 user-attribute-profiles rm
+  auth0 user-attribute-profiles delete <profile-id>
+  auth0 user-attribute-profiles delete <profile-id> --force
+  auth0 user-attribute-profiles delete <profile-id> <profile-id2> <profile-idn>
+  auth0 user-attribute-profiles delete <profile-id> <profile-id2> <profile-idn> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var ids []string
+			if len(args) == 0 {
+				if err := userAttributeProfileID.PickMany(cmd, &ids, cli.userAttributeProfilePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				ids = args
+			}
+
+			if !cli.force && cli.agentMode {
+				return errDestructiveNoConfirm
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			return ansi.ProgressBar("Deleting user attribute profile(s)", ids, func(_ int, id string) error {
+				if id != "" {
+					if err := cli.api.UserAttributeProfile.Delete(cmd.Context(), id); err != nil {
+						return fmt.Errorf("failed to delete user attribute profile with ID %q: %w", id, err)
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+// parseUserAttributeProfileMapping decodes the attribute mappings of a user
+// attribute profile and validates them, so that mistakes are reported before
+// anything is sent to the Management API.
+func parseUserAttributeProfileMapping(input string) (*userAttributeProfileMapping, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(input)))
+	decoder.DisallowUnknownFields()
+
+	var mapping userAttributeProfileMapping
+	if err := decoder.Decode(&mapping); err != nil {
+		return nil, fmt.Errorf("invalid JSON input for the attribute mappings: %w", err)
+	}
+
+	if err := validateUserAttributeProfileMapping(&mapping); err != nil {
+		return nil, fmt.Errorf("invalid attribute mappings: %w", err)
+	}
+
+	return &mapping, nil
+}
+
+func validateUserAttributeProfileMapping(mapping *userAttributeProfileMapping) error {
+	var (
+		errs                []error
+		unsupportedStrategy bool
+	)
+
+	if mapping.UserID != nil {
+		for strategy := range mapping.UserID.StrategyOverrides {
+			if !slices.Contains(userAttributeProfileStrategies, strategy) {
+				errs = append(errs, fmt.Errorf("user_id: unsupported strategy override %q", strategy))
+				unsupportedStrategy = true
+			}
+		}
+	}
+
+	if len(mapping.UserAttributes) == 0 {
+		errs = append(errs, errors.New("at least one user attribute is required"))
+	}
+
+	names := make([]string, 0, len(mapping.UserAttributes))
+	for name := range mapping.UserAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		attribute := mapping.UserAttributes[name]
+		if attribute == nil {
+			errs = append(errs, fmt.Errorf("user_attributes.%s: the attribute configuration is missing", name))
+			continue
+		}
+
+		if attribute.GetDescription() == "" {
+			errs = append(errs, fmt.Errorf("user_attributes.%s: description is required", name))
+		}
+		if attribute.GetLabel() == "" {
+			errs = append(errs, fmt.Errorf("user_attributes.%s: label is required", name))
+		}
+		if attribute.ProfileRequired == nil {
+			errs = append(errs, fmt.Errorf("user_attributes.%s: profile_required is required", name))
+		}
+		if attribute.GetAuth0Mapping() == "" {
+			errs = append(errs, fmt.Errorf("user_attributes.%s: auth0_mapping is required", name))
+		}
+		if attribute.OIDCMapping != nil && attribute.OIDCMapping.GetMapping() == "" {
+			errs = append(errs, fmt.Errorf("user_attributes.%s: oidc_mapping.mapping is required", name))
+		}
+
+		for strategy := range attribute.StrategyOverrides {
+			if !slices.Contains(userAttributeProfileStrategies, strategy) {
+				errs = append(errs, fmt.Errorf("user_attributes.%s: unsupported strategy override %q", name, strategy))
+				unsupportedStrategy = true
+			}
+		}
+	}
+
+	if unsupportedStrategy {
+		errs = append(errs, fmt.Errorf("supported strategies are: %s", strings.Join(userAttributeProfileStrategies, ", ")))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *cli) getUserAttributeProfiles(ctx context.Context, number int) ([]*management.UserAttributeProfile, error) {
+	var (
+		profiles []*management.UserAttributeProfile
+		from     string
+	)
+
+	for {
+		opts := []management.RequestOption{management.Take(100)}
+		if from != "" {
+			opts = append(opts, management.From(from))
+		}
+
+		list, err := c.api.UserAttributeProfile.List(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		profiles = append(profiles, list.UserAttributeProfiles...)
+
+		if number > 0 && len(profiles) >= number {
+			return profiles[:number], nil
+		}
+
+		if list.Next == "" {
+			return profiles, nil
+		}
+
+		from = list.Next
+	}
+}
+
+func (c *cli) userAttributeProfilePickerOptions(ctx context.Context) (pickerOptions, error) {
+	profiles, err := c.getUserAttributeProfiles(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, profile := range profiles {
+		value := profile.GetID()
+		label := fmt.Sprintf("%s %s", profile.GetName(), ansi.Faint("("+value+")"))
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no user attribute profiles to choose from. Create one by running: `auth0 user-attribute-profiles create`")
+	}
+
+	return opts, nil
+}
+
+func (c *cli) userAttributeProfileEditorHint() {
+	c.renderer.Infof("%s Once you close the editor, the attribute mappings will be validated and saved. To cancel, press CTRL+C.", ansi.Faint("Hint:"))
+}
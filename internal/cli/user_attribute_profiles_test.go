@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestParseUserAttributeProfileMapping(t *testing.T) {
+	t.Run("it parses the default mapping", func(t *testing.T) {
+		mapping, err := parseUserAttributeProfileMapping(defaultUserAttributeProfileMapping)
+		require.NoError(t, err)
+
+		assert.Equal(t, "sub", mapping.UserID.GetOIDCMapping())
+		require.Contains(t, mapping.UserAttributes, "email")
+		assert.Equal(t, "email", mapping.UserAttributes["email"].GetAuth0Mapping())
+	})
+
+	t.Run("it rejects unknown fields", func(t *testing.T) {
+		_, err := parseUserAttributeProfileMapping(`{"user_attributes": {"email": {"auth0_maping": "email"}}}`)
+		assert.ErrorContains(t, err, `unknown field "auth0_maping"`)
+	})
+
+	t.Run("it requires at least one user attribute", func(t *testing.T) {
+		_, err := parseUserAttributeProfileMapping(`{"user_id": {"oidc_mapping": "sub"}}`)
+		assert.ErrorContains(t, err, "at least one user attribute is required")
+		assert.NotContains(t, err.Error(), "supported strategies are")
+	})
+
+	t.Run("it reports every invalid mapping", func(t *testing.T) {
+		_, err := parseUserAttributeProfileMapping(`{
+			"user_id": {"strategy_overrides": {"github": {"oidc_mapping": "sub"}}},
+			"user_attributes": {
+				"email": {"label": "Email", "profile_required": true, "oidc_mapping": {}},
+				"name": {"description": "Name", "label": "Name", "profile_required": false, "auth0_mapping": "name",
+					"strategy_overrides": {"samlp": {}, "ldap": {}}}
+			}
+		}`)
+		require.Error(t, err)
+
+		assert.ErrorContains(t, err, `user_id: unsupported strategy override "github"`)
+		assert.ErrorContains(t, err, "user_attributes.email: description is required")
+		assert.ErrorContains(t, err, "user_attributes.email: auth0_mapping is required")
+		assert.ErrorContains(t, err, "user_attributes.email: oidc_mapping.mapping is required")
+		assert.ErrorContains(t, err, `user_attributes.name: unsupported strategy override "ldap"`)
+		assert.NotContains(t, err.Error(), `"samlp"`)
+		assert.ErrorContains(t, err, "supported strategies are: ")
+	})
+}
+
+func TestUserAttributeProfilesShowCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	profileAPI := mock.NewMockUserAttributeProfilesAPI(ctrl)
+	profileAPI.EXPECT().
+		Read(gomock.Any(), "uap_1").
+		Return(&management.UserAttributeProfile{
+			ID:   auth0.String("uap_1"),
+			Name: auth0.String("Enterprise Attributes"),
+		}, nil)
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+		api:      &auth0.API{UserAttributeProfile: profileAPI},
+	}
+
+	cmd := showUserAttributeProfileCmd(cli)
+	cmd.SetArgs([]string{"uap_1"})
+	require.NoError(t, cmd.Execute())
+
+	// The ID is part of the output, even though the SDK omits it when marshaling profiles.
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+	assert.Equal(t, "uap_1", output["id"])
+	assert.Equal(t, "Enterprise Attributes", output["name"])
+}
+
+func TestUserAttributeProfilesUpdateCmd(t *testing.T) {
+	current := &management.UserAttributeProfile{
+		ID:     auth0.String("uap_1"),
+		Name:   auth0.String("Enterprise Attributes"),
+		UserID: &management.UserAttributeProfileUserID{OIDCMapping: auth0.String("sub")},
+	}
+
+	t.Run("it keeps the user id mapping when only renaming the profile", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		profileAPI := mock.NewMockUserAttributeProfilesAPI(ctrl)
+		profileAPI.EXPECT().Read(gomock.Any(), "uap_1").Return(current, nil).Times(2)
+		profileAPI.EXPECT().
+			Update(gomock.Any(), "uap_1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, profile *management.UserAttributeProfile, _ ...management.RequestOption) error {
+				assert.Equal(t, "Workforce Attributes", profile.GetName())
+				assert.Equal(t, "sub", profile.UserID.GetOIDCMapping())
+				assert.Nil(t, profile.UserAttributes)
+				return nil
+			})
+
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			api:      &auth0.API{UserAttributeProfile: profileAPI},
+		}
+
+		cmd := updateUserAttributeProfileCmd(cli)
+		cmd.SetArgs([]string{"uap_1", "--name", "Workforce Attributes"})
+		require.NoError(t, cmd.Execute())
+	})
+
+	t.Run("it doesn't update the profile with invalid mappings", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		profileAPI := mock.NewMockUserAttributeProfilesAPI(ctrl)
+		profileAPI.EXPECT().Read(gomock.Any(), "uap_1").Return(current, nil)
+
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			api:      &auth0.API{UserAttributeProfile: profileAPI},
+		}
+
+		cmd := updateUserAttributeProfileCmd(cli)
+		cmd.SetArgs([]string{"uap_1", "--mapping", `{"user_attributes": {}}`})
+		assert.ErrorContains(t, cmd.Execute(), "invalid attribute mappings")
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"os"
 
 	"github.com/auth0/go-auth0/management"
 	"github.com/spf13/cobra"
@@ -140,6 +141,27 @@ var (
 		IsRequired: false,
 	}
 
+	userImportWait = Flag{
+		Name:       "Wait",
+		LongForm:   "wait",
+		Help:       "Wait for the import job to complete and report the users that failed to import. Exits with a non-zero code if any user failed to import.",
+		IsRequired: false,
+	}
+
+	userImportCSV = Flag{
+		Name:       "CSV File",
+		LongForm:   "from-csv",
+		Help:       "Path to a CSV file with the users to be imported. Cannot be used if the '--users' or '--template' flags are passed.",
+		IsRequired: false,
+	}
+
+	userImportMap = Flag{
+		Name:       "Column Mapping",
+		LongForm:   "map",
+		Help:       "Mapping of user fields to the CSV columns holding their value, e.g. 'email=Email,app_metadata.plan=Plan'. Defaults to the column headers.",
+		IsRequired: false,
+	}
+
 	userPicker = Flag{
 		Name:      "Interactive picker option on rendered users during search",
 		LongForm:  "picker",
@@ -173,6 +195,10 @@ func usersCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(openUserCmd(cli))
 	cmd.AddCommand(userBlocksCmd(cli))
 	cmd.AddCommand(importUsersCmd(cli))
+	cmd.AddCommand(exportUsersCmd(cli))
+	cmd.AddCommand(verifyEmailUsersCmd(cli))
+	cmd.AddCommand(passwordResetTicketUsersCmd(cli))
+	cmd.AddCommand(userJobsCmd(cli))
 	cmd.AddCommand(userSessionsCmd(cli))
 	cmd.AddCommand(userRefreshTokensCmd(cli))
 
@@ -867,13 +893,19 @@ func importUsersCmd(cli *cli) *cobra.Command {
 		UsersBody           string
 		Upsert              bool
 		SendCompletionEmail bool
+		Wait                bool
+		FromCSV             string
+		Map                 map[string]string
 	}
 	cmd := &cobra.Command{
 		Use:   "import",
 		Args:  cobra.NoArgs,
 		Short: "Import users from schema",
 		Long: `Import users from schema. Issues a Create Import Users Job. 
-The file size limit for a bulk import is 500KB. You will need to start multiple imports if your data exceeds this size.`,
+The file size limit for a bulk import is 500KB. Larger payloads are split into multiple jobs. With '--wait', the jobs are run one at a time, otherwise they are all started at once.
+
+Users can also be imported from a CSV file with '--from-csv'. Columns are mapped to user fields with '--map',
+using dots for nested fields, e.g. 'app_metadata.plan=Plan'. Without a mapping, the column headers are used as user fields.`,
 		Example: `  auth0 users import
   auth0 users import --connection-name "Username-Password-Authentication"
   auth0 users import --connection-name "Username-Password-Authentication" --users "[]"
@@ -887,7 +919,10 @@ The file size limit for a bulk import is 500KB. You will need to start multiple
   cat path/to/users.json | auth0 users import -c "Username-Password-Authentication" --upsert --email-results
   auth0 users import -c "Username-Password-Authentication" -t "Basic Example" --upsert --email-results
   auth0 users import -c "Username-Password-Authentication" -t "Basic Example" --upsert=false --email-results=false
-  auth0 users import -c "Username-Password-Authentication" -t "Basic Example" --upsert=false --email-results=false`,
+  auth0 users import -c "Username-Password-Authentication" -t "Basic Example" --upsert=false --email-results=false
+  auth0 users import -c "Username-Password-Authentication" --users "$(cat path/to/users.json)" --wait --no-input
+  auth0 users import -c "Username-Password-Authentication" --from-csv users.csv
+  auth0 users import -c "Username-Password-Authentication" --from-csv users.csv --map email=Email,name=FullName,app_metadata.plan=Plan --wait`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Users API currently only supports database connections.
 			dbConnectionOptions, err := cli.databaseAndPasswordlessConnectionOptions(cmd.Context())
@@ -917,12 +952,24 @@ The file size limit for a bulk import is 500KB. You will need to start multiple
 				)
 			}
 
+			if len(inputs.Map) > 0 && inputs.FromCSV == "" {
+				return errors.New("the '--map' flag can only be used along with '--from-csv'")
+			}
+
+			var usersBody []map[string]interface{}
+			if inputs.FromCSV != "" {
+				usersBody, err = readUsersFromCSV(inputs.FromCSV, inputs.Map)
+				if err != nil {
+					return err
+				}
+			}
+
 			pipedUsersBody := iostream.PipedInput()
-			if len(pipedUsersBody) > 0 && inputs.UsersBody == "" {
+			if len(pipedUsersBody) > 0 && inputs.UsersBody == "" && inputs.FromCSV == "" {
 				inputs.UsersBody = string(pipedUsersBody)
 			}
 
-			if inputs.UsersBody == "" {
+			if inputs.UsersBody == "" && inputs.FromCSV == "" {
 				err := userImportTemplate.Select(cmd, &inputs.Template, userImportOptions.labels(), nil)
 				if err != nil {
 					return err
@@ -949,9 +996,10 @@ The file size limit for a bulk import is 500KB. You will need to start multiple
 				}
 			}
 
-			var usersBody []map[string]interface{}
-			if err := json.Unmarshal([]byte(inputs.UsersBody), &usersBody); err != nil {
-				return fmt.Errorf("invalid JSON input: %w", err)
+			if inputs.FromCSV == "" {
+				if err := json.Unmarshal([]byte(inputs.UsersBody), &usersBody); err != nil {
+					return fmt.Errorf("invalid JSON input: %w", err)
+				}
 			}
 
 			job := &management.Job{
@@ -961,6 +1009,16 @@ The file size limit for a bulk import is 500KB. You will need to start multiple
 				SendCompletionEmail: &inputs.SendCompletionEmail,
 			}
 
+			batches, err := users.Chunk(usersBody, users.MaxImportSize)
+			if err != nil {
+				return err
+			}
+
+			if len(batches) > 1 {
+				cli.renderer.Infof("The users exceed the size limit of a single import job and will be imported in %d batches.", len(batches))
+				return cli.importUserBatches(cmd.Context(), *job, batches, inputs.Wait)
+			}
+
 			if err := ansi.Waiting(func() error {
 				return cli.api.Jobs.ImportUsers(cmd.Context(), job)
 			}); err != nil {
@@ -969,13 +1027,18 @@ The file size limit for a bulk import is 500KB. You will need to start multiple
 
 			cli.renderer.Heading("started user import job")
 			cli.renderer.Infof("Job with ID '%s' successfully started.", ansi.Bold(job.GetID()))
-			cli.renderer.Infof("Run '%s' to get the status of the job.", ansi.Cyan("auth0 api jobs/"+job.GetID()))
 
-			if inputs.SendCompletionEmail {
-				cli.renderer.Infof("Results of your user import job will be sent to your email.")
+			if !inputs.Wait {
+				cli.renderer.Infof("Run '%s' to get the status of the job.", ansi.Cyan("auth0 users jobs show "+job.GetID()))
+
+				if inputs.SendCompletionEmail {
+					cli.renderer.Infof("Results of your user import job will be sent to your email.")
+				}
+
+				return nil
 			}
 
-			return nil
+			return cli.waitForUserImport(cmd.Context(), job.GetID())
 		},
 	}
 
@@ -984,11 +1047,29 @@ The file size limit for a bulk import is 500KB. You will need to start multiple
 	userImportBody.RegisterString(cmd, &inputs.UsersBody, "")
 	userEmailResults.RegisterBool(cmd, &inputs.SendCompletionEmail, true)
 	userImportUpsert.RegisterBool(cmd, &inputs.Upsert, false)
-	cmd.MarkFlagsMutuallyExclusive("template", "users")
+	userImportWait.RegisterBool(cmd, &inputs.Wait, false)
+	userImportCSV.RegisterString(cmd, &inputs.FromCSV, "")
+	userImportMap.RegisterStringMap(cmd, &inputs.Map, nil)
+	cmd.MarkFlagsMutuallyExclusive("template", "users", "from-csv")
 
 	return cmd
 }
 
+func readUsersFromCSV(path string, mapping map[string]string) ([]map[string]interface{}, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CSV file %q: %w", path, err)
+	}
+	defer file.Close()
+
+	usersBody, err := users.FromCSV(file, mapping)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert CSV file %q: %w", path, err)
+	}
+
+	return usersBody, nil
+}
+
 func formatUserDetailsPath(id string) string {
 	if len(id) == 0 {
 		return ""
@@ -0,0 +1,368 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var (
+	userBulkFilter = Flag{
+		Name:      "Filter",
+		LongForm:  "filter",
+		ShortForm: "q",
+		Help: "Search query in Lucene query syntax selecting the users, instead of passing their ids. " +
+			"For example: email_verified:false AND identities.connection:\"Username-Password-Authentication\".",
+	}
+
+	userBulkNumber = Flag{
+		Name:      "Number",
+		LongForm:  "number",
+		ShortForm: "n",
+		Help:      "Maximum number of users, that match the filter, to process. Minimum 1, maximum 1000.",
+	}
+
+	userBulkOutput = Flag{
+		Name:      "Output",
+		LongForm:  "output",
+		ShortForm: "o",
+		Help:      "Path of a CSV file to write the outcome for each user to.",
+	}
+
+	userBulkClientID = Flag{
+		Name:      "Client ID",
+		LongForm:  "client-id",
+		ShortForm: "c",
+		Help:      "Client ID of the application whose settings, such as its name and login URL, are used in the email or the ticket.",
+	}
+
+	userTicketResultURL = Flag{
+		Name:      "Result URL",
+		LongForm:  "result-url",
+		ShortForm: "r",
+		Help:      "URL the users are redirected to once they changed their password.",
+	}
+
+	userTicketTTL = Flag{
+		Name:      "TTL Seconds",
+		LongForm:  "ttl-sec",
+		ShortForm: "t",
+		Help:      "Number of seconds for which the ticket is valid before expiration. Defaults to 432000 (5 days).",
+	}
+
+	userTicketMarkEmailVerified = Flag{
+		Name:     "Mark Email As Verified",
+		LongForm: "mark-email-verified",
+		Help:     "Whether the email of the users is marked as verified once they changed their password.",
+	}
+)
+
+// userBulkTarget is a user processed by a bulk run.
+type userBulkTarget struct {
+	ID    string
+	Email string
+}
+
+func verifyEmailUsersCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Filter   string
+		Number   int
+		ClientID string
+		Output   string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "verify-email",
+		Args:  cobra.ArbitraryArgs,
+		Short: "Send verification emails to users",
+		Long: "Send an email to verify their email address to one or more users, through a Send Verification Email Job per user.\n\n" +
+			"Pass the ids of the users, or select them with a search query through `--filter`. " +
+			"The outcome for each user can be written to a CSV file through `--output`.",
+		Example: `  auth0 users verify-email <user-id>
+  auth0 users verify-email <user-id> <user-id2> <user-idn>
+  auth0 users verify-email <user-id> --client-id <client-id>
+  auth0 users verify-email --filter "email_verified:false" --number 500 --force
+  auth0 users verify-email -q "email_verified:false" -o outcome.csv --force
+  auth0 users verify-email -q "email_verified:false" --force --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			targets, err := cli.userBulkTargets(cmd, args, inputs.Filter, inputs.Number, "send a verification email to")
+			if err != nil || len(targets) == 0 {
+				return err
+			}
+
+			outcomes, err := runUserBulk(targets, "Sending verification email(s)", func(target userBulkTarget) (string, error) {
+				job := &management.Job{UserID: auth0.String(target.ID)}
+				if inputs.ClientID != "" {
+					job.ClientID = auth0.String(inputs.ClientID)
+				}
+
+				if err := cli.api.Jobs.VerifyEmail(cmd.Context(), job); err != nil {
+					return "", err
+				}
+
+				return job.GetID(), nil
+			})
+
+			return cli.renderUserBulkOutcomes("verification emails", "Job ID", inputs.Output, outcomes, err)
+		},
+	}
+
+	userBulkFilter.RegisterString(cmd, &inputs.Filter, "")
+	userBulkNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+	userBulkClientID.RegisterString(cmd, &inputs.ClientID, "")
+	userBulkOutput.RegisterString(cmd, &inputs.Output, "")
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	return cmd
+}
+
+func passwordResetTicketUsersCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Filter            string
+		Number            int
+		ClientID          string
+		ResultURL         string
+		TTLSec            int
+		MarkEmailVerified bool
+		Output            string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "password-reset-ticket",
+		Args:  cobra.ArbitraryArgs,
+		Short: "Create password reset tickets for users",
+		Long: "Create a password change ticket for one or more users. The ticket is a URL that lets the user set a new password, " +
+			"which you can share with them through your own channels.\n\n" +
+			"Pass the ids of the users, or select them with a search query through `--filter`. " +
+			"The outcome for each user, including the ticket URL, can be written to a CSV file through `--output`.",
+		Example: `  auth0 users password-reset-ticket <user-id>
+  auth0 users password-reset-ticket <user-id> --result-url "https://example.com/login"
+  auth0 users password-reset-ticket <user-id> -r "https://example.com/login" --ttl-sec 3600 --mark-email-verified
+  auth0 users password-reset-ticket <user-id> <user-id2> <user-idn> --client-id <client-id>
+  auth0 users password-reset-ticket --filter "identities.connection:\"Legacy-DB\"" -o tickets.csv --force
+  auth0 users password-reset-ticket -q "identities.connection:\"Legacy-DB\"" --force --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.TTLSec < 0 {
+				return fmt.Errorf("ttl-sec flag invalid, please pass a positive number of seconds")
+			}
+
+			targets, err := cli.userBulkTargets(cmd, args, inputs.Filter, inputs.Number, "create a password reset ticket for")
+			if err != nil || len(targets) == 0 {
+				return err
+			}
+
+			outcomes, err := runUserBulk(targets, "Creating password reset ticket(s)", func(target userBulkTarget) (string, error) {
+				ticket := &management.Ticket{UserID: auth0.String(target.ID)}
+				if inputs.ResultURL != "" {
+					ticket.ResultURL = auth0.String(inputs.ResultURL)
+				}
+				if inputs.ClientID != "" {
+					ticket.ClientID = auth0.String(inputs.ClientID)
+				}
+				if inputs.TTLSec > 0 {
+					ticket.TTLSec = auth0.Int(inputs.TTLSec)
+				}
+				if userTicketMarkEmailVerified.IsSet(cmd) {
+					ticket.MarkEmailAsVerified = auth0.Bool(inputs.MarkEmailVerified)
+				}
+
+				if err := cli.api.Ticket.ChangePassword(cmd.Context(), ticket); err != nil {
+					return "", err
+				}
+
+				return ticket.GetTicket(), nil
+			})
+
+			return cli.renderUserBulkOutcomes("password reset tickets", "Ticket", inputs.Output, outcomes, err)
+		},
+	}
+
+	userBulkFilter.RegisterString(cmd, &inputs.Filter, "")
+	userBulkNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+	userBulkClientID.RegisterString(cmd, &inputs.ClientID, "")
+	userTicketResultURL.RegisterString(cmd, &inputs.ResultURL, "")
+	userTicketTTL.RegisterInt(cmd, &inputs.TTLSec, 0)
+	userTicketMarkEmailVerified.RegisterBool(cmd, &inputs.MarkEmailVerified, false)
+	userBulkOutput.RegisterString(cmd, &inputs.Output, "")
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	return cmd
+}
+
+// userBulkTargets returns the users passed as arguments or else the ones matching
+// the filter. Users selected by a filter must be confirmed, unless forced, and
+// can't be acted on in agent mode without --force.
+func (c *cli) userBulkTargets(cmd *cobra.Command, ids []string, filter string, number int, action string) ([]userBulkTarget, error) {
+	if len(ids) > 0 && filter != "" {
+		return nil, errors.New("please pass either user ids or a filter, not both")
+	}
+
+	if filter == "" {
+		if len(ids) == 0 {
+			var id string
+			if err := userID.Ask(cmd, &id); err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+
+		targets := make([]userBulkTarget, 0, len(ids))
+		for _, id := range ids {
+			targets = append(targets, userBulkTarget{ID: id})
+		}
+
+		return targets, nil
+	}
+
+	if number < 1 || number > 1000 {
+		return nil, fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
+	}
+
+	users, err := c.searchUsers(cmd.Context(), filter, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		c.renderer.Warnf("No users match the filter.")
+		return nil, nil
+	}
+
+	if !c.force && c.agentMode {
+		return nil, errDestructiveNoConfirm
+	}
+
+	if !c.force && canPrompt(cmd) {
+		message := fmt.Sprintf("Are you sure you want to %s %d user(s)?", action, len(users))
+		if confirmed := prompt.Confirm(message); !confirmed {
+			return nil, nil
+		}
+	}
+
+	targets := make([]userBulkTarget, 0, len(users))
+	for _, user := range users {
+		targets = append(targets, userBulkTarget{ID: user.GetID(), Email: user.GetEmail()})
+	}
+
+	return targets, nil
+}
+
+func (c *cli) searchUsers(ctx context.Context, query string, number int) ([]*management.User, error) {
+	list, err := getWithPagination(
+		number,
+		func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+			opts = append(opts, management.Query(query))
+
+			userList, err := c.api.User.Search(ctx, opts...)
+			if err != nil {
+				return nil, false, err
+			}
+
+			for _, user := range userList.Users {
+				result = append(result, user)
+			}
+
+			return result, userList.HasNext(), nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for users: %w", err)
+	}
+
+	users := make([]*management.User, 0, len(list))
+	for _, item := range list {
+		users = append(users, item.(*management.User))
+	}
+
+	return users, nil
+}
+
+// runUserBulk runs the operation for each user, recording its outcome. Failures
+// don't stop the run and are reported together once every user was processed.
+func runUserBulk(targets []userBulkTarget, description string, operation func(userBulkTarget) (string, error)) ([]display.UserBulkOutcome, error) {
+	indexes := make([]int, len(targets))
+	for i := range targets {
+		indexes[i] = i
+	}
+
+	outcomes := make([]display.UserBulkOutcome, len(targets))
+	var failed int
+
+	_ = ansi.ProgressBar(description, indexes, func(_ int, i int) error {
+		target := targets[i]
+		outcome := display.UserBulkOutcome{
+			UserID: target.ID,
+			Email:  target.Email,
+			Status: display.UserBulkOutcomeSucceeded,
+		}
+
+		result, err := operation(target)
+		if err != nil {
+			outcome.Status = display.UserBulkOutcomeFailed
+			outcome.Error = err.Error()
+			failed++
+		}
+		outcome.Result = result
+
+		outcomes[i] = outcome
+		return nil
+	})
+
+	if failed > 0 {
+		return outcomes, fmt.Errorf("failed for %d of %d user(s)", failed, len(targets))
+	}
+
+	return outcomes, nil
+}
+
+// renderUserBulkOutcomes renders the outcomes, writes them to the
+// CSV file if requested, and passes through the error of the run.
+func (c *cli) renderUserBulkOutcomes(heading, resultLabel, output string, outcomes []display.UserBulkOutcome, runErr error) error {
+	c.renderer.UserBulkOutcomes(heading, resultLabel, outcomes)
+
+	if output != "" {
+		if err := writeUserBulkOutcomes(output, heading, resultLabel, outcomes); err != nil {
+			return fmt.Errorf("failed to write the outcome to %q: %w", output, err)
+		}
+		c.renderer.Infof("Wrote the outcome for %d user(s) to %s", len(outcomes), ansi.Bold(output))
+	}
+
+	return runErr
+}
+
+func writeUserBulkOutcomes(path, heading, resultLabel string, outcomes []display.UserBulkOutcome) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	csvRenderer := &display.Renderer{
+		MessageWriter: io.Discard,
+		ResultWriter:  file,
+		Format:        display.OutputFormatCSV,
+	}
+	csvRenderer.UserBulkOutcomes(heading, resultLabel, outcomes)
+
+	return file.Close()
+}
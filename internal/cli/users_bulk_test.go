@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestUsersVerifyEmailCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jobsAPI := mock.NewMockJobsAPI(ctrl)
+	jobsAPI.EXPECT().
+		VerifyEmail(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, job *management.Job, _ ...management.RequestOption) error {
+			if job.GetUserID() == "auth0|2" {
+				return errors.New("user not found")
+			}
+			assert.Equal(t, "client_1", job.GetClientID())
+			job.ID = auth0.String("job_1")
+			return nil
+		}).
+		Times(2)
+
+	output := filepath.Join(t.TempDir(), "outcome.csv")
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Jobs: jobsAPI},
+	}
+
+	cmd := verifyEmailUsersCmd(cli)
+	cmd.SetArgs([]string{"auth0|1", "auth0|2", "--client-id", "client_1", "--output", output})
+	assert.EqualError(t, cmd.Execute(), "failed for 1 of 2 user(s)")
+
+	// The outcome is written for every user, even when some of them failed.
+	content, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, "User ID,Email,Status,Job ID,Error\n"+
+		"auth0|1,,succeeded,job_1,\n"+
+		"auth0|2,,failed,,user not found\n", string(content))
+}
+
+func TestUsersPasswordResetTicketCmd(t *testing.T) {
+	t.Run("it creates a ticket for each user matching the filter", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userAPI := mock.NewMockUserAPI(ctrl)
+		userAPI.EXPECT().
+			Search(gomock.Any(), gomock.Any()).
+			Return(&management.UserList{Users: []*management.User{
+				{ID: auth0.String("auth0|1"), Email: auth0.String("jane@example.com")},
+			}}, nil)
+
+		ticketAPI := mock.NewMockTicketAPI(ctrl)
+		ticketAPI.EXPECT().
+			ChangePassword(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, ticket *management.Ticket, _ ...management.RequestOption) error {
+				assert.Equal(t, "auth0|1", ticket.GetUserID())
+				assert.Equal(t, "https://example.com/login", ticket.GetResultURL())
+				assert.Equal(t, 3600, ticket.GetTTLSec())
+				assert.Nil(t, ticket.MarkEmailAsVerified)
+				ticket.Ticket = auth0.String("https://example.auth0.com/lo/reset?ticket=abc")
+				return nil
+			})
+
+		buf := &bytes.Buffer{}
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+			api:      &auth0.API{User: userAPI, Ticket: ticketAPI},
+		}
+
+		cmd := passwordResetTicketUsersCmd(cli)
+		cmd.SetArgs([]string{
+			"--filter", `identities.connection:"Legacy-DB"`,
+			"--result-url", "https://example.com/login",
+			"--ttl-sec", "3600",
+			"--force",
+		})
+		require.NoError(t, cmd.Execute())
+
+		assert.JSONEq(t, `[{
+			"user_id": "auth0|1",
+			"email": "jane@example.com",
+			"status": "succeeded",
+			"result": "https://example.auth0.com/lo/reset?ticket=abc"
+		}]`, buf.String())
+	})
+
+	t.Run("it refuses to act on the users matching the filter without confirmation in agent mode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userAPI := mock.NewMockUserAPI(ctrl)
+		userAPI.EXPECT().
+			Search(gomock.Any(), gomock.Any()).
+			Return(&management.UserList{Users: []*management.User{{ID: auth0.String("auth0|1")}}}, nil)
+
+		cli := &cli{
+			renderer:  &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			api:       &auth0.API{User: userAPI, Ticket: mock.NewMockTicketAPI(ctrl)},
+			agentMode: true,
+		}
+
+		cmd := passwordResetTicketUsersCmd(cli)
+		cmd.SetArgs([]string{"--filter", `identities.connection:"Legacy-DB"`})
+		assert.ErrorIs(t, cmd.Execute(), errDestructiveNoConfirm)
+	})
+
+	t.Run("it rejects both user ids and a filter", func(t *testing.T) {
+		cli := &cli{renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard}}
+
+		cmd := passwordResetTicketUsersCmd(cli)
+		cmd.SetArgs([]string{"auth0|1", "--filter", "email_verified:false"})
+		assert.EqualError(t, cmd.Execute(), "please pass either user ids or a filter, not both")
+	})
+}
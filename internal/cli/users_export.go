@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+var (
+	userExportConnection = Flag{
+		Name:      "Connection",
+		LongForm:  "connection",
+		ShortForm: "c",
+		Help:      "Name of the connection to export users from. Exports users from all connections if omitted.",
+	}
+
+	userExportFields = Flag{
+		Name:      "Fields",
+		LongForm:  "fields",
+		ShortForm: "f",
+		Help:      "Comma-separated list of user fields to include in the export. A set of predefined fields is exported if omitted.",
+	}
+
+	userExportFormat = Flag{
+		Name:     "Format",
+		LongForm: "format",
+		Help:     "Format of the exported file. Options include: 'csv' and 'json'.",
+	}
+
+	userExportOutput = Flag{
+		Name:      "Output",
+		LongForm:  "output",
+		ShortForm: "o",
+		Help:      "Path of the file to write the exported users to. Writes to standard output if omitted.",
+	}
+)
+
+func exportUsersCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		JobID      string
+		Connection string
+		Fields     []string
+		Format     string
+		Output     string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export [job-id]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Export users to a file",
+		Long: "Export users to a file. Issues a Create Export Users Job, waits for it to complete and " +
+			"downloads the result.\n\n" +
+			"To resume waiting on a previously started export, pass the ID of its job as an argument.",
+		Example: `  auth0 users export --output users.csv
+  auth0 users export --connection "Username-Password-Authentication" --output users.csv
+  auth0 users export -c "Username-Password-Authentication" --fields user_id,email,name -o users.csv
+  auth0 users export -c "Username-Password-Authentication" --format json -o users.json
+  auth0 users export -c "Username-Password-Authentication" --format json > users.json
+  auth0 users export <job-id> --output users.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				inputs.JobID = args[0]
+			}
+
+			if inputs.Format != "csv" && inputs.Format != "json" {
+				return fmt.Errorf("invalid format %q, please use 'csv' or 'json'", inputs.Format)
+			}
+
+			if inputs.JobID == "" {
+				job := &management.Job{
+					Format: auth0.String(inputs.Format),
+					Fields: userExportJobFields(inputs.Fields),
+				}
+
+				if inputs.Connection != "" {
+					connection, err := cli.api.Connection.ReadByName(cmd.Context(), inputs.Connection)
+					if err != nil {
+						return fmt.Errorf("failed to read connection with name %q: %w", inputs.Connection, err)
+					}
+					job.ConnectionID = connection.ID
+				}
+
+				if err := ansi.Waiting(func() error {
+					return cli.api.Jobs.ExportUsers(cmd.Context(), job)
+				}); err != nil {
+					return fmt.Errorf("failed to export users: %w", err)
+				}
+
+				inputs.JobID = job.GetID()
+				cli.renderer.Infof("Job with ID '%s' successfully started.", ansi.Bold(inputs.JobID))
+				cli.renderer.Infof("If interrupted, run '%s' to resume.", ansi.Cyan("auth0 users export "+inputs.JobID))
+			}
+
+			var job *management.Job
+			if err := ansi.Spinner("Waiting for the export to complete", func() (err error) {
+				job, err = cli.waitForUserJob(cmd.Context(), inputs.JobID)
+				return err
+			}); err != nil {
+				return err
+			}
+
+			if job.GetStatus() == userJobStatusFailed {
+				return fmt.Errorf("job with ID %q failed", inputs.JobID)
+			}
+
+			if job.GetType() != userJobTypeExport {
+				return fmt.Errorf("job with ID %q is not a user export job", inputs.JobID)
+			}
+
+			if err := ansi.Spinner("Downloading the exported users", func() error {
+				if inputs.Output == "" {
+					return downloadUserExport(cmd.Context(), job.GetLocation(), cli.renderer.ResultWriter)
+				}
+				return downloadUserExportToFile(cmd.Context(), job.GetLocation(), inputs.Output)
+			}); err != nil {
+				return fmt.Errorf("failed to download the exported users: %w", err)
+			}
+
+			if inputs.Output != "" {
+				cli.renderer.Infof("Users successfully exported to %s", ansi.Bold(inputs.Output))
+			}
+
+			return nil
+		},
+	}
+
+	userExportConnection.RegisterString(cmd, &inputs.Connection, "")
+	userExportFields.RegisterStringSlice(cmd, &inputs.Fields, nil)
+	userExportFormat.RegisterString(cmd, &inputs.Format, "csv")
+	userExportOutput.RegisterString(cmd, &inputs.Output, "")
+
+	return cmd
+}
+
+func userExportJobFields(fields []string) []map[string]interface{} {
+	var jobFields []map[string]interface{}
+	for _, field := range fields {
+		jobFields = append(jobFields, map[string]interface{}{"name": field})
+	}
+	return jobFields
+}
+
+// downloadUserExportToFile downloads the export to a temporary file next to the
+// given one, which is only replaced once the download succeeded.
+func downloadUserExportToFile(ctx context.Context, location, path string) (err error) {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create file %q: %w", path, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	if err := downloadUserExport(ctx, location, file); err != nil {
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// downloadUserExport writes the file found at the location of an export job
+// to the writer, decompressing it when gzipped.
+func downloadUserExport(ctx context.Context, location string, w io.Writer) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	body := bufio.NewReader(response.Body)
+
+	var reader io.Reader = body
+	if magic, err := body.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(body)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	_, err = io.Copy(w, reader)
+	return err
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadUserExport(t *testing.T) {
+	var compressed bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressed)
+	_, err := gzipWriter.Write([]byte("user_id,email\nauth0|1,a@example.com\n"))
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users.csv.gz":
+			_, _ = w.Write(compressed.Bytes())
+		case "/users.csv":
+			_, _ = w.Write([]byte("user_id,email\n"))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	t.Run("decompresses gzipped exports", func(t *testing.T) {
+		var output bytes.Buffer
+		require.NoError(t, downloadUserExport(context.Background(), server.URL+"/users.csv.gz", &output))
+		assert.Equal(t, "user_id,email\nauth0|1,a@example.com\n", output.String())
+	})
+
+	t.Run("copies uncompressed exports as is", func(t *testing.T) {
+		var output bytes.Buffer
+		require.NoError(t, downloadUserExport(context.Background(), server.URL+"/users.csv", &output))
+		assert.Equal(t, "user_id,email\n", output.String())
+	})
+
+	t.Run("returns an error on unexpected status codes", func(t *testing.T) {
+		var output bytes.Buffer
+		err := downloadUserExport(context.Background(), server.URL+"/expired", &output)
+		assert.EqualError(t, err, "unexpected status code: 403")
+	})
+
+	t.Run("replaces the output file once the download succeeded", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "users.csv")
+		require.NoError(t, os.WriteFile(path, []byte("previous export\n"), 0600))
+
+		require.NoError(t, downloadUserExportToFile(context.Background(), server.URL+"/users.csv", path))
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "user_id,email\n", string(content))
+	})
+
+	t.Run("leaves the output file untouched when the download fails", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "users.csv")
+		require.NoError(t, os.WriteFile(path, []byte("previous export\n"), 0600))
+
+		err := downloadUserExportToFile(context.Background(), server.URL+"/expired", path)
+		assert.EqualError(t, err, "unexpected status code: 403")
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "previous export\n", string(content))
+
+		// The temporary file is removed.
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+}
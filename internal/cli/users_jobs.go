@@ -0,0 +1,317 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+const (
+	userJobStatusCompleted = "completed"
+	userJobStatusFailed    = "failed"
+	userJobTypeExport      = "users_export"
+)
+
+// userJobPollInterval is the time to wait between checks on the status of a job.
+var userJobPollInterval = 2 * time.Second
+
+var (
+	userJobID = Argument{
+		Name: "Job ID",
+		Help: "Id of the job.",
+	}
+
+	userJobWait = Flag{
+		Name:     "Wait",
+		LongForm: "wait",
+		Help:     "Wait for the job to complete.",
+	}
+)
+
+func userJobsCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jobs",
+		Short: "Manage user import and export jobs",
+		Long:  "Check the status and the errors of user import and export jobs.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(showUserJobCmd(cli))
+	cmd.AddCommand(listUserJobErrorsCmd(cli))
+
+	return cmd
+}
+
+func showUserJobCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID   string
+		Wait bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show a user job",
+		Long:  "Display the status of a user import or export job, along with a summary of the processed users.",
+		Example: `  auth0 users jobs show
+  auth0 users jobs show <job-id>
+  auth0 users jobs show <job-id> --wait
+  auth0 users jobs show <job-id> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := userJobID.Ask(cmd, &inputs.ID); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var job *management.Job
+			if inputs.Wait {
+				if err := ansi.Spinner("Waiting for the job to complete", func() (err error) {
+					job, err = cli.waitForUserJob(cmd.Context(), inputs.ID)
+					return err
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := ansi.Waiting(func() (err error) {
+					job, err = cli.api.Jobs.Read(cmd.Context(), inputs.ID)
+					return err
+				}); err != nil {
+					return fmt.Errorf("failed to read job with ID %q: %w", inputs.ID, err)
+				}
+			}
+
+			cli.renderer.UserJobShow(job)
+
+			return nil
+		},
+	}
+
+	userJobWait.RegisterBool(cmd, &inputs.Wait, false)
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact")
+
+	return cmd
+}
+
+func listUserJobErrorsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "errors",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "List the errors of a user import job",
+		Long:  "List the users rejected by a user import job, along with the validation errors reported for each of them.",
+		Example: `  auth0 users jobs errors
+  auth0 users jobs errors <job-id>
+  auth0 users jobs errors <job-id> --json
+  auth0 users jobs errors <job-id> --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := userJobID.Ask(cmd, &inputs.ID); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var jobErrors []management.JobError
+			if err := ansi.Waiting(func() (err error) {
+				jobErrors, err = cli.readUserJobErrors(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return err
+			}
+
+			cli.renderer.UserJobErrorList(jobErrors)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.jsonCompact, "json-compact", false, "Output in compact json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "json-compact", "csv")
+
+	return cmd
+}
+
+// waitForUserJob polls the job until it has either completed or failed.
+func (c *cli) waitForUserJob(ctx context.Context, id string) (*management.Job, error) {
+	for {
+		job, err := c.api.Jobs.Read(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read job with ID %q: %w", id, err)
+		}
+
+		switch job.GetStatus() {
+		case userJobStatusCompleted, userJobStatusFailed:
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(userJobPollInterval):
+		}
+	}
+}
+
+// readUserJobErrors fetches the users rejected by a job. The endpoint responds
+// with the job itself instead of a list when there are no errors to report.
+func (c *cli) readUserJobErrors(ctx context.Context, id string) ([]management.JobError, error) {
+	var response json.RawMessage
+	if err := c.api.HTTPClient.Request(ctx, http.MethodGet, c.api.HTTPClient.URI("jobs", id, "errors"), &response); err != nil {
+		return nil, fmt.Errorf("failed to read errors of job with ID %q: %w", id, err)
+	}
+
+	jobErrors := []management.JobError{}
+	if len(response) == 0 || response[0] != '[' {
+		return jobErrors, nil
+	}
+
+	if err := json.Unmarshal(response, &jobErrors); err != nil {
+		return nil, fmt.Errorf("failed to parse errors of job with ID %q: %w", id, err)
+	}
+
+	return jobErrors, nil
+}
+
+// waitForUserImport waits for an import job to complete, then renders its
+// summary along with the users that failed to import, if any.
+func (c *cli) waitForUserImport(ctx context.Context, id string) error {
+	var job *management.Job
+	if err := ansi.Spinner("Waiting for the import to complete", func() (err error) {
+		job, err = c.waitForUserJob(ctx, id)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	return c.reportUserImport(ctx, []*management.Job{job})
+}
+
+// importUserBatches imports each batch of users through its own job. Unless
+// the jobs are only started, they are run one at a time and their results are
+// reported once all of them completed, or once one of them failed to run.
+func (c *cli) importUserBatches(ctx context.Context, job management.Job, batches [][]map[string]interface{}, wait bool) error {
+	var jobs []*management.Job
+	for i, batch := range batches {
+		batchJob := &management.Job{
+			ConnectionID:        job.ConnectionID,
+			Users:               batch,
+			Upsert:              job.Upsert,
+			SendCompletionEmail: job.SendCompletionEmail,
+		}
+
+		if err := ansi.Spinner(fmt.Sprintf("Importing batch %d of %d", i+1, len(batches)), func() error {
+			if err := c.api.Jobs.ImportUsers(ctx, batchJob); err != nil {
+				return fmt.Errorf("failed to import users: %w", err)
+			}
+
+			if !wait {
+				jobs = append(jobs, batchJob)
+				return nil
+			}
+
+			completed, err := c.waitForUserJob(ctx, batchJob.GetID())
+			if err != nil {
+				return err
+			}
+
+			jobs = append(jobs, completed)
+
+			return nil
+		}); err != nil {
+			err = fmt.Errorf("failed to import batch %d of %d: %w", i+1, len(batches), err)
+			if len(jobs) == 0 {
+				return err
+			}
+
+			if !wait {
+				c.renderStartedUserImports(jobs, job.GetSendCompletionEmail())
+				return err
+			}
+
+			// The results of the batches imported before the failure are reported, the failure taking precedence.
+			_ = c.reportUserImport(ctx, jobs)
+			return err
+		}
+	}
+
+	if !wait {
+		c.renderStartedUserImports(jobs, job.GetSendCompletionEmail())
+		return nil
+	}
+
+	return c.reportUserImport(ctx, jobs)
+}
+
+// renderStartedUserImports lists the import jobs that were started without waiting for them.
+func (c *cli) renderStartedUserImports(jobs []*management.Job, sendCompletionEmail bool) {
+	c.renderer.UserJobList(jobs)
+	c.renderer.Infof("Run '%s' to get the status of a job.", ansi.Cyan("auth0 users jobs show <job-id>"))
+
+	if sendCompletionEmail {
+		c.renderer.Infof("Results of your user import jobs will be sent to your email.")
+	}
+}
+
+// reportUserImport renders the summary of the completed import jobs along with
+// the users that failed to import. An error is returned if any user failed.
+func (c *cli) reportUserImport(ctx context.Context, jobs []*management.Job) error {
+	if len(jobs) == 1 {
+		c.renderer.UserJobShow(jobs[0])
+	} else {
+		c.renderer.UserJobList(jobs)
+	}
+
+	var (
+		total, failed int
+		failedJob     string
+		jobErrors     = []management.JobError{}
+	)
+	for _, job := range jobs {
+		total += job.GetSummary().GetTotal()
+		failed += job.GetSummary().GetFailed()
+
+		if job.GetStatus() == userJobStatusFailed && failedJob == "" {
+			failedJob = job.GetID()
+		}
+
+		if job.GetStatus() != userJobStatusFailed && job.GetSummary().GetFailed() == 0 {
+			continue
+		}
+
+		errs, err := c.readUserJobErrors(ctx, job.GetID())
+		if err != nil {
+			return err
+		}
+		jobErrors = append(jobErrors, errs...)
+	}
+
+	if failedJob == "" && failed == 0 {
+		return nil
+	}
+
+	c.renderer.UserJobErrorList(jobErrors)
+
+	if failedJob != "" {
+		return fmt.Errorf("job with ID %q failed", failedJob)
+	}
+
+	return fmt.Errorf("%d of %d users failed to import", failed, total)
+}
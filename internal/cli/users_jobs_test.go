@@ -0,0 +1,242 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestWaitForUserJob(t *testing.T) {
+	defer func(interval time.Duration) { userJobPollInterval = interval }(userJobPollInterval)
+	userJobPollInterval = time.Millisecond
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jobsAPI := mock.NewMockJobsAPI(ctrl)
+	gomock.InOrder(
+		jobsAPI.EXPECT().Read(gomock.Any(), "job_1").Return(&management.Job{Status: auth0.String("pending")}, nil),
+		jobsAPI.EXPECT().Read(gomock.Any(), "job_1").Return(&management.Job{Status: auth0.String("processing")}, nil),
+		jobsAPI.EXPECT().Read(gomock.Any(), "job_1").Return(&management.Job{
+			Status:   auth0.String("completed"),
+			Location: auth0.String("https://example.com/users.csv.gz"),
+		}, nil),
+	)
+
+	cli := &cli{api: &auth0.API{Jobs: jobsAPI}}
+
+	job, err := cli.waitForUserJob(context.Background(), "job_1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/users.csv.gz", job.GetLocation())
+}
+
+func expectUserJobErrors(httpClientAPI *mock.MockHTTPClientAPI, response string) {
+	httpClientAPI.EXPECT().URI("jobs", "job_1", "errors").Return("https://example.auth0.com/api/v2/jobs/job_1/errors")
+	httpClientAPI.EXPECT().
+		Request(gomock.Any(), http.MethodGet, "https://example.auth0.com/api/v2/jobs/job_1/errors", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, payload interface{}, _ ...management.RequestOption) error {
+			return json.Unmarshal([]byte(response), payload)
+		})
+}
+
+func TestReadUserJobErrors(t *testing.T) {
+	t.Run("returns the rejected users", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		httpClientAPI := mock.NewMockHTTPClientAPI(ctrl)
+		expectUserJobErrors(httpClientAPI, `[{"user":{"email":"a@example.com"},"errors":[{"code":"INVALID_FORMAT","message":"Invalid email","path":"email"}]}]`)
+
+		cli := &cli{api: &auth0.API{HTTPClient: httpClientAPI}}
+
+		jobErrors, err := cli.readUserJobErrors(context.Background(), "job_1")
+		require.NoError(t, err)
+		require.Len(t, jobErrors, 1)
+		assert.Equal(t, "a@example.com", jobErrors[0].User["email"])
+		assert.Equal(t, "INVALID_FORMAT", jobErrors[0].Errors[0].Code)
+	})
+
+	t.Run("returns no errors when the job is returned instead", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		httpClientAPI := mock.NewMockHTTPClientAPI(ctrl)
+		expectUserJobErrors(httpClientAPI, `{"id":"job_1","status":"completed"}`)
+
+		cli := &cli{api: &auth0.API{HTTPClient: httpClientAPI}}
+
+		jobErrors, err := cli.readUserJobErrors(context.Background(), "job_1")
+		require.NoError(t, err)
+		assert.Empty(t, jobErrors)
+	})
+}
+
+func TestWaitForUserImport(t *testing.T) {
+	newCLI := func(ctrl *gomock.Controller, job *management.Job) (*cli, *mock.MockHTTPClientAPI) {
+		jobsAPI := mock.NewMockJobsAPI(ctrl)
+		jobsAPI.EXPECT().Read(gomock.Any(), "job_1").Return(job, nil)
+
+		httpClientAPI := mock.NewMockHTTPClientAPI(ctrl)
+
+		return &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			api:      &auth0.API{Jobs: jobsAPI, HTTPClient: httpClientAPI},
+		}, httpClientAPI
+	}
+
+	t.Run("succeeds when every user was imported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cli, _ := newCLI(ctrl, &management.Job{
+			ID:      auth0.String("job_1"),
+			Status:  auth0.String("completed"),
+			Summary: &management.JobSummary{Total: auth0.Int(2), Inserted: auth0.Int(2), Failed: auth0.Int(0)},
+		})
+
+		assert.NoError(t, cli.waitForUserImport(context.Background(), "job_1"))
+	})
+
+	t.Run("fails when some users were rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cli, httpClientAPI := newCLI(ctrl, &management.Job{
+			ID:      auth0.String("job_1"),
+			Status:  auth0.String("completed"),
+			Summary: &management.JobSummary{Total: auth0.Int(2), Inserted: auth0.Int(1), Failed: auth0.Int(1)},
+		})
+		expectUserJobErrors(httpClientAPI, `[{"user":{"email":"a@example.com"},"errors":[{"code":"INVALID_FORMAT","message":"Invalid email","path":"email"}]}]`)
+
+		assert.EqualError(t, cli.waitForUserImport(context.Background(), "job_1"), "1 of 2 users failed to import")
+	})
+
+	t.Run("fails when the job failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cli, httpClientAPI := newCLI(ctrl, &management.Job{ID: auth0.String("job_1"), Status: auth0.String("failed")})
+		expectUserJobErrors(httpClientAPI, `{"id":"job_1","status":"failed"}`)
+
+		assert.EqualError(t, cli.waitForUserImport(context.Background(), "job_1"), `job with ID "job_1" failed`)
+	})
+}
+
+func TestImportUserBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var imported [][]map[string]interface{}
+
+	jobsAPI := mock.NewMockJobsAPI(ctrl)
+	jobsAPI.EXPECT().ImportUsers(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(_ context.Context, job *management.Job, _ ...management.RequestOption) error {
+			imported = append(imported, job.Users)
+			job.ID = auth0.String(fmt.Sprintf("job_%d", len(imported)))
+			return nil
+		})
+	jobsAPI.EXPECT().Read(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(_ context.Context, id string, _ ...management.RequestOption) (*management.Job, error) {
+			return &management.Job{
+				ID:      auth0.String(id),
+				Status:  auth0.String("completed"),
+				Summary: &management.JobSummary{Total: auth0.Int(1), Inserted: auth0.Int(1), Failed: auth0.Int(0)},
+			}, nil
+		})
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Jobs: jobsAPI},
+	}
+
+	batches := [][]map[string]interface{}{
+		{{"email": "jane@example.com"}},
+		{{"email": "john@example.com"}},
+	}
+
+	job := management.Job{ConnectionID: auth0.String("con_1"), Upsert: auth0.Bool(true)}
+	require.NoError(t, cli.importUserBatches(context.Background(), job, batches, true))
+	assert.Equal(t, batches, imported)
+}
+
+func TestImportUserBatchesWithoutWaiting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var started int
+
+	jobsAPI := mock.NewMockJobsAPI(ctrl)
+	jobsAPI.EXPECT().ImportUsers(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(_ context.Context, job *management.Job, _ ...management.RequestOption) error {
+			started++
+			job.ID = auth0.String(fmt.Sprintf("job_%d", started))
+			job.Status = auth0.String("pending")
+			return nil
+		})
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+		api:      &auth0.API{Jobs: jobsAPI},
+	}
+
+	batches := [][]map[string]interface{}{
+		{{"email": "jane@example.com"}},
+		{{"email": "john@example.com"}},
+	}
+
+	require.NoError(t, cli.importUserBatches(context.Background(), management.Job{ConnectionID: auth0.String("con_1")}, batches, false))
+	assert.Contains(t, buf.String(), `"job_1"`)
+	assert.Contains(t, buf.String(), `"job_2"`)
+}
+
+func TestImportUserBatchesReportsTheCompletedBatchesOnFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jobsAPI := mock.NewMockJobsAPI(ctrl)
+	gomock.InOrder(
+		jobsAPI.EXPECT().ImportUsers(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, job *management.Job, _ ...management.RequestOption) error {
+				job.ID = auth0.String("job_1")
+				return nil
+			}),
+		jobsAPI.EXPECT().ImportUsers(gomock.Any(), gomock.Any()).
+			Return(testManagementError{message: "Too Many Requests", status: 429}),
+	)
+	jobsAPI.EXPECT().Read(gomock.Any(), "job_1").
+		Return(&management.Job{
+			ID:      auth0.String("job_1"),
+			Status:  auth0.String("completed"),
+			Summary: &management.JobSummary{Total: auth0.Int(1), Inserted: auth0.Int(1), Failed: auth0.Int(0)},
+		}, nil)
+
+	buf := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: buf, Format: display.OutputFormatJSON},
+		api:      &auth0.API{Jobs: jobsAPI},
+	}
+
+	batches := [][]map[string]interface{}{
+		{{"email": "jane@example.com"}},
+		{{"email": "john@example.com"}},
+	}
+
+	err := cli.importUserBatches(context.Background(), management.Job{ConnectionID: auth0.String("con_1")}, batches, true)
+	assert.ErrorContains(t, err, "failed to import batch 2 of 2")
+	assert.Contains(t, buf.String(), `"job_1"`)
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/v3/jwt"
+
+	"github.com/auth0/auth0-cli/internal/keyring"
 )
 
 // ErrConfigFileMissing is thrown when the config.json file is missing.
@@ -19,6 +21,9 @@ var ErrConfigFileMissing = errors.New("config.json file is missing")
 // ErrNoAuthenticatedTenants is thrown when the config file has no authenticated tenants.
 var ErrNoAuthenticatedTenants = errors.New("not logged in. Try `auth0 login`")
 
+// ErrCredentialStore is thrown when the credential store selected in the config can't be used.
+var ErrCredentialStore = errors.New("failed to set up the credential store")
+
 // Config holds cli configuration settings.
 type Config struct {
 	onlyOnce  sync.Once
@@ -26,9 +31,15 @@ type Config struct {
 
 	path string
 
-	InstallID     string  `json:"install_id,omitempty"`
-	DefaultTenant string  `json:"default_tenant"`
-	Tenants       Tenants `json:"tenants"`
+	InstallID      string   `json:"install_id,omitempty"`
+	DefaultTenant  string   `json:"default_tenant"`
+	DefaultProfile string   `json:"default_profile,omitempty"`
+	Tenants        Tenants  `json:"tenants"`
+	Profiles       Profiles `json:"profiles,omitempty"`
+
+	// CredentialStore selects where the secrets of the tenants are kept.
+	// Defaults to the OS keyring.
+	CredentialStore *keyring.Options `json:"credential_store,omitempty"`
 }
 
 // Initialize will load the config settings into memory.
@@ -227,7 +238,34 @@ func (c *Config) loadFromDisk() error {
 		return err
 	}
 
-	return json.Unmarshal(buffer, c)
+	if err := json.Unmarshal(buffer, c); err != nil {
+		return err
+	}
+
+	return c.useCredentialStore()
+}
+
+// useCredentialStore makes the keyring keep the secrets in the
+// credential store selected in the config. The file backend
+// defaults to a file next to the config file.
+func (c *Config) useCredentialStore() error {
+	if c.CredentialStore == nil {
+		return nil
+	}
+
+	options := *c.CredentialStore
+	if options.Backend == keyring.BackendFile && options.Path == "" {
+		options.Path = filepath.Join(filepath.Dir(c.path), "credentials")
+	}
+
+	store, err := keyring.NewStore(options)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCredentialStore, err)
+	}
+
+	keyring.UseStore(store)
+
+	return nil
 }
 
 func (c *Config) saveToDisk() error {
@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zalando/go-keyring"
+
+	auth0keyring "github.com/auth0/auth0-cli/internal/keyring"
 )
 
 func TestDefaultPath(t *testing.T) {
@@ -113,6 +115,54 @@ func TestConfig_LoadFromDisk(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, expectedConfig, config)
 	})
+
+	t.Run("it keeps the secrets in the credential store of the config file", func(t *testing.T) {
+		keyring.MockInit()
+		t.Setenv(auth0keyring.PassphraseEnvVar, "correct horse battery staple")
+		t.Cleanup(func() {
+			store, err := auth0keyring.NewStore(auth0keyring.Options{})
+			require.NoError(t, err)
+			auth0keyring.UseStore(store)
+		})
+
+		// The credentials file is created next to the config file.
+		tempFile := path.Join(t.TempDir(), "config.json")
+		require.NoError(t, os.WriteFile(tempFile, []byte(`
+		{
+			"install_id": "3998b053-dd7f-4bfe-bb10-c4f3a96a0180",
+			"default_tenant": "",
+			"tenants": {},
+			"credential_store": {"backend": "file"}
+		}
+		`), 0600))
+
+		config := &Config{path: tempFile}
+		require.NoError(t, config.loadFromDisk())
+		require.NoError(t, auth0keyring.StoreClientSecret("auth0-cli.eu.auth0.com", "some-client-secret"))
+
+		_, err := keyring.Get("Auth0 CLI Client Secret", "auth0-cli.eu.auth0.com")
+		assert.ErrorIs(t, err, keyring.ErrNotFound)
+		assert.FileExists(t, path.Join(path.Dir(tempFile), "credentials"))
+	})
+
+	t.Run("it fails to load a config file with an unusable credential store", func(t *testing.T) {
+		t.Setenv(auth0keyring.PassphraseEnvVar, "")
+
+		tempFile := createTempConfigFile(t, []byte(`
+		{
+			"install_id": "3998b053-dd7f-4bfe-bb10-c4f3a96a0180",
+			"default_tenant": "",
+			"tenants": {},
+			"credential_store": {"backend": "file"}
+		}
+		`))
+
+		config := &Config{path: tempFile}
+		err := config.loadFromDisk()
+
+		assert.ErrorIs(t, err, ErrCredentialStore)
+		assert.EqualError(t, err, "failed to set up the credential store: the file credential store requires a passphrase in the AUTH0_CREDENTIALS_PASSPHRASE environment variable")
+	})
 }
 
 func TestConfig_SaveToDisk(t *testing.T) {
@@ -0,0 +1,124 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ProfileOutputs are the output formats a profile can default to.
+var ProfileOutputs = []string{"json", "json-compact", "csv"}
+
+// ErrProfileNotFound is thrown when a profile is missing from the config.
+var ErrProfileNotFound = errors.New("profile not found")
+
+type (
+	// Profiles keeps track of the named profiles.
+	// The key is the name of the profile.
+	Profiles map[string]Profile
+
+	// Profile bundles the defaults of the commands run with it,
+	// so that they don't have to be passed as flags every time.
+	Profile struct {
+		Name            string `json:"name"`
+		Tenant          string `json:"tenant"`
+		DefaultAppID    string `json:"default_app_id,omitempty"`
+		DefaultAudience string `json:"default_audience,omitempty"`
+		Output          string `json:"output,omitempty"`
+		NoColor         bool   `json:"no_color,omitempty"`
+	}
+)
+
+// GetProfile retrieves a profile from the config.
+func (c *Config) GetProfile(name string) (Profile, error) {
+	if err := c.Initialize(); err != nil && !errors.Is(err, ErrConfigFileMissing) {
+		return Profile{}, err
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf(
+			"%w: %s. Run 'auth0 profiles list' to see your profiles or 'auth0 profiles create' to create one",
+			ErrProfileNotFound,
+			name,
+		)
+	}
+
+	return profile, nil
+}
+
+// AddProfile adds a profile to the config. The tenant of the
+// profile must be one that we logged into.
+func (c *Config) AddProfile(profile Profile) error {
+	if strings.TrimSpace(profile.Name) == "" {
+		return errors.New("the name of the profile can't be empty")
+	}
+
+	if _, err := c.GetTenant(profile.Tenant); err != nil {
+		return err
+	}
+
+	if profile.Output != "" && !slices.Contains(ProfileOutputs, profile.Output) {
+		return fmt.Errorf(
+			"invalid output %q, please use one of: %s",
+			profile.Output,
+			strings.Join(ProfileOutputs, ", "),
+		)
+	}
+
+	if c.Profiles == nil {
+		c.Profiles = make(map[string]Profile)
+	}
+
+	c.Profiles[profile.Name] = profile
+
+	return c.saveToDisk()
+}
+
+// RemoveProfile removes a profile from the config, and
+// stops using it by default if it was the default one.
+func (c *Config) RemoveProfile(name string) error {
+	if _, err := c.GetProfile(name); err != nil {
+		return err
+	}
+
+	delete(c.Profiles, name)
+
+	if c.DefaultProfile == name {
+		c.DefaultProfile = ""
+	}
+
+	return c.saveToDisk()
+}
+
+// ListAllProfiles retrieves a list with all the profiles, sorted by name.
+func (c *Config) ListAllProfiles() ([]Profile, error) {
+	if err := c.Initialize(); err != nil && !errors.Is(err, ErrConfigFileMissing) {
+		return nil, err
+	}
+
+	profiles := make([]Profile, 0, len(c.Profiles))
+	for _, profile := range c.Profiles {
+		profiles = append(profiles, profile)
+	}
+
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+
+	return profiles, nil
+}
+
+// SetDefaultProfile saves the new default profile to the disk.
+func (c *Config) SetDefaultProfile(name string) error {
+	profile, err := c.GetProfile(name)
+	if err != nil {
+		return err
+	}
+
+	c.DefaultProfile = profile.Name
+
+	return c.saveToDisk()
+}
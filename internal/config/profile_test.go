@@ -0,0 +1,135 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const profilesTestConfig = `{
+	"install_id": "3998b053-dd7f-4bfe-bb10-c4f3a96a0180",
+	"default_tenant": "auth0-cli.eu.auth0.com",
+	"default_profile": "staging",
+	"tenants": {
+		"auth0-cli.eu.auth0.com": {
+			"name": "auth0-cli",
+			"domain": "auth0-cli.eu.auth0.com",
+			"access_token": "eyfSaswe",
+			"expires_at": "2023-04-18T11:18:07.998809Z",
+			"client_id": "secret"
+		}
+	},
+	"profiles": {
+		"staging": {
+			"name": "staging",
+			"tenant": "auth0-cli.eu.auth0.com",
+			"output": "json"
+		}
+	}
+}`
+
+func TestConfig_AddProfile(t *testing.T) {
+	t.Run("it can successfully add a profile", func(t *testing.T) {
+		config := &Config{path: createTempConfigFile(t, []byte(profilesTestConfig))}
+
+		err := config.AddProfile(Profile{
+			Name:            "dev",
+			Tenant:          "auth0-cli.eu.auth0.com",
+			DefaultAppID:    "client-id",
+			DefaultAudience: "https://api.example.com",
+			Output:          "csv",
+			NoColor:         true,
+		})
+		require.NoError(t, err)
+
+		assertConfigFileMatches(t, config.path, `{
+    "install_id": "3998b053-dd7f-4bfe-bb10-c4f3a96a0180",
+    "default_tenant": "auth0-cli.eu.auth0.com",
+    "default_profile": "staging",
+    "tenants": {
+        "auth0-cli.eu.auth0.com": {
+            "name": "auth0-cli",
+            "domain": "auth0-cli.eu.auth0.com",
+            "access_token": "eyfSaswe",
+            "expires_at": "2023-04-18T11:18:07.998809Z",
+            "client_id": "secret"
+        }
+    },
+    "profiles": {
+        "dev": {
+            "name": "dev",
+            "tenant": "auth0-cli.eu.auth0.com",
+            "default_app_id": "client-id",
+            "default_audience": "https://api.example.com",
+            "output": "csv",
+            "no_color": true
+        },
+        "staging": {
+            "name": "staging",
+            "tenant": "auth0-cli.eu.auth0.com",
+            "output": "json"
+        }
+    }
+}`)
+	})
+
+	t.Run("it fails to add a profile for a tenant we're not logged into", func(t *testing.T) {
+		config := &Config{path: createTempConfigFile(t, []byte(profilesTestConfig))}
+
+		err := config.AddProfile(Profile{Name: "dev", Tenant: "auth0-mega-cli.eu.auth0.com"})
+		assert.EqualError(t, err, "failed to find tenant: auth0-mega-cli.eu.auth0.com. Run 'auth0 tenants list' to see your configured tenants or run 'auth0 login' to configure a new tenant")
+	})
+
+	t.Run("it fails to add a profile with an invalid output", func(t *testing.T) {
+		config := &Config{path: createTempConfigFile(t, []byte(profilesTestConfig))}
+
+		err := config.AddProfile(Profile{Name: "dev", Tenant: "auth0-cli.eu.auth0.com", Output: "yaml"})
+		assert.EqualError(t, err, `invalid output "yaml", please use one of: json, json-compact, csv`)
+	})
+}
+
+func TestConfig_RemoveProfile(t *testing.T) {
+	t.Run("it removes the profile and stops using it by default", func(t *testing.T) {
+		config := &Config{path: createTempConfigFile(t, []byte(profilesTestConfig))}
+
+		require.NoError(t, config.RemoveProfile("staging"))
+
+		assertConfigFileMatches(t, config.path, `{
+    "install_id": "3998b053-dd7f-4bfe-bb10-c4f3a96a0180",
+    "default_tenant": "auth0-cli.eu.auth0.com",
+    "tenants": {
+        "auth0-cli.eu.auth0.com": {
+            "name": "auth0-cli",
+            "domain": "auth0-cli.eu.auth0.com",
+            "access_token": "eyfSaswe",
+            "expires_at": "2023-04-18T11:18:07.998809Z",
+            "client_id": "secret"
+        }
+    }
+}`)
+	})
+
+	t.Run("it fails to remove a profile that doesn't exist", func(t *testing.T) {
+		config := &Config{path: createTempConfigFile(t, []byte(profilesTestConfig))}
+
+		err := config.RemoveProfile("production")
+		assert.ErrorIs(t, err, ErrProfileNotFound)
+	})
+}
+
+func TestConfig_SetDefaultProfile(t *testing.T) {
+	config := &Config{path: createTempConfigFile(t, []byte(profilesTestConfig))}
+	require.NoError(t, config.AddProfile(Profile{Name: "dev", Tenant: "auth0-cli.eu.auth0.com"}))
+
+	require.NoError(t, config.SetDefaultProfile("dev"))
+	assert.Equal(t, "dev", config.DefaultProfile)
+
+	assert.ErrorIs(t, config.SetDefaultProfile("production"), ErrProfileNotFound)
+
+	profiles, err := config.ListAllProfiles()
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+	assert.Equal(t, "dev", profiles[0].Name)
+	assert.Equal(t, "staging", profiles[1].Name)
+}
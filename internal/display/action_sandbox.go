@@ -0,0 +1,83 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ActionTestResult holds the outcome of running an action locally.
+type ActionTestResult struct {
+	Commands []ActionTestCommand `json:"commands"`
+	Logs     []string            `json:"logs"`
+	Error    string              `json:"error,omitempty"`
+}
+
+// ActionTestCommand is a call made by an action on the api object.
+type ActionTestCommand struct {
+	Name string        `json:"name"`
+	Args []interface{} `json:"args"`
+}
+
+type actionTestCommandView struct {
+	Position  string
+	Name      string
+	Arguments string
+	raw       interface{}
+}
+
+func (v *actionTestCommandView) AsTableHeader() []string {
+	return []string{"#", "Command", "Arguments"}
+}
+
+func (v *actionTestCommandView) AsTableRow() []string {
+	return []string{v.Position, v.Name, v.Arguments}
+}
+
+func (v *actionTestCommandView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) ActionTestResult(result *ActionTestResult) {
+	switch r.Format {
+	case OutputFormatJSON:
+		r.JSONResult(result)
+		return
+	case OutputFormatJSONCompact:
+		r.JSONCompactResult(result)
+		return
+	}
+
+	if len(result.Logs) > 0 {
+		r.Heading("console output")
+		for _, line := range result.Logs {
+			r.Detailf("%s", line)
+		}
+	}
+
+	resource := "recorded api calls"
+
+	r.Heading(fmt.Sprintf("%s (%d)", resource, len(result.Commands)))
+
+	if len(result.Commands) == 0 {
+		r.EmptyState(resource, "The action did not call any method of the api object.")
+		return
+	}
+
+	var res []View
+	for i, command := range result.Commands {
+		args, err := json.Marshal(command.Args)
+		if err != nil {
+			args = []byte(fmt.Sprint(command.Args))
+		}
+
+		res = append(res, &actionTestCommandView{
+			Position:  strconv.Itoa(i + 1),
+			Name:      command.Name,
+			Arguments: string(args),
+			raw:       command,
+		})
+	}
+
+	r.Results(res)
+}
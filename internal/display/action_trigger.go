@@ -0,0 +1,103 @@
+package display
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type actionTriggerView struct {
+	ID      string
+	Version string
+	Status  string
+	raw     interface{}
+}
+
+func (v *actionTriggerView) AsTableHeader() []string {
+	return []string{"ID", "Version", "Status"}
+}
+
+func (v *actionTriggerView) AsTableRow() []string {
+	return []string{v.ID, v.Version, v.Status}
+}
+
+func (v *actionTriggerView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) ActionTriggerList(triggers []*management.ActionTrigger) {
+	resource := "triggers"
+
+	r.Heading(fmt.Sprintf("%s (%d)", resource, len(triggers)))
+
+	if len(triggers) == 0 {
+		r.EmptyState(resource, "")
+		return
+	}
+
+	var res []View
+	for _, trigger := range triggers {
+		res = append(res, &actionTriggerView{
+			ID:      trigger.GetID(),
+			Version: trigger.GetVersion(),
+			Status:  trigger.GetStatus(),
+			raw:     trigger,
+		})
+	}
+
+	r.Results(res)
+}
+
+type actionBindingView struct {
+	Position   string
+	ActionID   string
+	ActionName string
+	ID         string
+	raw        interface{}
+}
+
+func (v *actionBindingView) AsTableHeader() []string {
+	return []string{"Position", "Action ID", "Action Name", "Binding ID"}
+}
+
+func (v *actionBindingView) AsTableRow() []string {
+	return []string{v.Position, ansi.Faint(v.ActionID), v.ActionName, ansi.Faint(v.ID)}
+}
+
+func (v *actionBindingView) Object() interface{} {
+	return v.raw
+}
+
+// ActionBindingList renders the actions bound to a
+// trigger in the order in which they are executed.
+func (r *Renderer) ActionBindingList(triggerID string, bindings []*management.ActionBinding) {
+	resource := triggerID + " bindings"
+
+	r.Heading(fmt.Sprintf("%s (%d)", resource, len(bindings)))
+
+	if len(bindings) == 0 {
+		r.EmptyState(resource, "Use 'auth0 actions triggers bind' to add one")
+		return
+	}
+
+	var res []View
+	for i, binding := range bindings {
+		name := binding.GetDisplayName()
+		if name == "" {
+			name = binding.GetAction().GetName()
+		}
+
+		res = append(res, &actionBindingView{
+			Position:   strconv.Itoa(i + 1),
+			ActionID:   binding.GetAction().GetID(),
+			ActionName: name,
+			ID:         binding.GetID(),
+			raw:        binding,
+		})
+	}
+
+	r.Results(res)
+}
@@ -209,6 +209,27 @@ func (r *Renderer) ApplicationUpdate(client *management.Client, revealSecrets bo
 	r.Result(makeApplicationView(client, revealSecrets))
 }
 
+func (r *Renderer) ApplicationSecretsRotated(clients []*management.Client, revealSecrets bool) {
+	resource := "rotated secrets"
+
+	r.Heading(fmt.Sprintf("%s (%v)", resource, len(clients)))
+
+	var res []View
+	for _, c := range clients {
+		if !revealSecrets {
+			c.ClientSecret = auth0.String("")
+		}
+
+		res = append(res, makeApplicationView(c, revealSecrets))
+	}
+
+	r.Results(res)
+
+	if !revealSecrets {
+		r.Infof("%s Use --reveal-secrets to display the new secrets.", ansi.Faint("Hint:"))
+	}
+}
+
 func makeApplicationView(client *management.Client, revealSecrets bool) *applicationView {
 	jsonRefreshToken, _ := json.Marshal(client.GetRefreshToken())
 
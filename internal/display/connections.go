@@ -0,0 +1,134 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type connectionView struct {
+	ID                 string
+	Name               string
+	DisplayName        string
+	Strategy           string
+	IsDomainConnection bool
+	ShowAsButton       bool
+	Realms             []string
+	raw                interface{}
+}
+
+func (v *connectionView) AsTableHeader() []string {
+	return []string{"ID", "Name", "Strategy", "Display Name"}
+}
+
+func (v *connectionView) AsTableRow() []string {
+	return []string{
+		ansi.Faint(v.ID),
+		v.Name,
+		v.Strategy,
+		v.DisplayName,
+	}
+}
+
+func (v *connectionView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"DISPLAY NAME", v.DisplayName},
+		{"STRATEGY", v.Strategy},
+		{"DOMAIN CONNECTION", boolean(v.IsDomainConnection)},
+		{"SHOW AS BUTTON", boolean(v.ShowAsButton)},
+		{"REALMS", strings.Join(v.Realms, ", ")},
+	}
+}
+
+func (v *connectionView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) ConnectionList(connections []*management.Connection) {
+	resource := "connections"
+
+	r.Heading(fmt.Sprintf("%s (%d)", resource, len(connections)))
+
+	if len(connections) == 0 {
+		r.EmptyState(resource, "Use 'auth0 connections create' to add one")
+		return
+	}
+
+	var res []View
+	for _, connection := range connections {
+		res = append(res, makeConnectionView(connection))
+	}
+
+	r.Results(res)
+}
+
+func (r *Renderer) ConnectionShow(connection *management.Connection) {
+	r.Heading("connection")
+	r.Result(makeConnectionView(connection))
+}
+
+func (r *Renderer) ConnectionCreate(connection *management.Connection) {
+	r.Heading("connection created")
+	r.Result(makeConnectionView(connection))
+}
+
+func (r *Renderer) ConnectionUpdate(connection *management.Connection) {
+	r.Heading("connection updated")
+	r.Result(makeConnectionView(connection))
+}
+
+func makeConnectionView(connection *management.Connection) *connectionView {
+	return &connectionView{
+		ID:                 connection.GetID(),
+		Name:               connection.GetName(),
+		DisplayName:        connection.GetDisplayName(),
+		Strategy:           connection.GetStrategy(),
+		IsDomainConnection: connection.GetIsDomainConnection(),
+		ShowAsButton:       connection.GetShowAsButton(),
+		Realms:             connection.GetRealms(),
+		raw:                connection,
+	}
+}
+
+type connectionEnabledClientView struct {
+	ClientID string
+	raw      interface{}
+}
+
+func (v *connectionEnabledClientView) AsTableHeader() []string {
+	return []string{"Client ID"}
+}
+
+func (v *connectionEnabledClientView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ClientID)}
+}
+
+func (v *connectionEnabledClientView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) ConnectionEnabledClientList(clients []management.ConnectionEnabledClient) {
+	resource := "enabled clients"
+
+	r.Heading(fmt.Sprintf("%s (%d)", resource, len(clients)))
+
+	if len(clients) == 0 {
+		r.EmptyState(resource, "Use 'auth0 connections enabled-clients add' to enable one")
+		return
+	}
+
+	var res []View
+	for _, client := range clients {
+		res = append(res, &connectionEnabledClientView{
+			ClientID: client.GetClientID(),
+			raw:      client,
+		})
+	}
+
+	r.Results(res)
+}
@@ -0,0 +1,207 @@
+package display
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type flowView struct {
+	ID         string
+	Name       string
+	Actions    string
+	CreatedAt  string
+	UpdatedAt  string
+	ExecutedAt string
+	raw        *management.Flow
+}
+
+// flowObject is the JSON output of a flow, since
+// management.Flow omits its read-only fields when marshaled.
+type flowObject struct {
+	ID         *string       `json:"id,omitempty"`
+	Name       *string       `json:"name,omitempty"`
+	Actions    []interface{} `json:"actions,omitempty"`
+	CreatedAt  *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time    `json:"updated_at,omitempty"`
+	ExecutedAt *time.Time    `json:"executed_at,omitempty"`
+}
+
+func (v *flowView) AsTableHeader() []string {
+	return []string{"ID", "Name", "Actions", "Updated"}
+}
+
+func (v *flowView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ID), v.Name, v.Actions, v.UpdatedAt}
+}
+
+func (v *flowView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"ACTIONS", v.Actions},
+		{"CREATED", v.CreatedAt},
+		{"UPDATED", v.UpdatedAt},
+		{"EXECUTED", v.ExecutedAt},
+	}
+}
+
+func (v *flowView) Object() interface{} {
+	return &flowObject{
+		ID:         v.raw.ID,
+		Name:       v.raw.Name,
+		Actions:    v.raw.Actions,
+		CreatedAt:  v.raw.CreatedAt,
+		UpdatedAt:  v.raw.UpdatedAt,
+		ExecutedAt: v.raw.ExecutedAt,
+	}
+}
+
+func (r *Renderer) FlowList(flows []*management.Flow) {
+	resource := "flows"
+
+	r.Heading(resource)
+
+	if len(flows) == 0 {
+		r.EmptyState(resource, "Use 'auth0 flows create' to add one")
+		return
+	}
+
+	var res []View
+	for _, flow := range flows {
+		res = append(res, makeFlowView(flow))
+	}
+
+	r.Results(res)
+}
+
+func (r *Renderer) FlowShow(flow *management.Flow) {
+	r.Heading("flow")
+	r.Result(makeFlowView(flow))
+}
+
+func (r *Renderer) FlowCreate(flow *management.Flow) {
+	r.Heading("flow created")
+	r.Result(makeFlowView(flow))
+}
+
+func (r *Renderer) FlowUpdate(flow *management.Flow) {
+	r.Heading("flow updated")
+	r.Result(makeFlowView(flow))
+}
+
+func makeFlowView(flow *management.Flow) *flowView {
+	view := &flowView{
+		ID:      flow.GetID(),
+		Name:    flow.GetName(),
+		Actions: strconv.Itoa(len(flow.Actions)),
+		raw:     flow,
+	}
+
+	if flow.CreatedAt != nil {
+		view.CreatedAt = timeAgo(flow.GetCreatedAt())
+	}
+	if flow.UpdatedAt != nil {
+		view.UpdatedAt = timeAgo(flow.GetUpdatedAt())
+	}
+	if flow.ExecutedAt != nil {
+		view.ExecutedAt = timeAgo(flow.GetExecutedAt())
+	}
+
+	return view
+}
+
+type flowVaultConnectionView struct {
+	ID          string
+	Name        string
+	AppID       string
+	Environment string
+	AccountName string
+	Ready       bool
+	CreatedAt   string
+	raw         *management.FlowVaultConnection
+}
+
+// flowVaultConnectionObject is the JSON output of a flow vault connection, since
+// management.FlowVaultConnection omits its read-only fields when marshaled.
+// The setup holds secrets and is never part of the output.
+type flowVaultConnectionObject struct {
+	ID          *string    `json:"id,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	AppID       *string    `json:"app_id,omitempty"`
+	Environment *string    `json:"environment,omitempty"`
+	AccountName *string    `json:"account_name,omitempty"`
+	Ready       *bool      `json:"ready,omitempty"`
+	Fingerprint *string    `json:"fingerprint,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	RefreshedAt *time.Time `json:"refreshed_at,omitempty"`
+}
+
+func (v *flowVaultConnectionView) AsTableHeader() []string {
+	return []string{"ID", "Name", "App", "Account", "Ready"}
+}
+
+func (v *flowVaultConnectionView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ID), v.Name, v.AppID, v.AccountName, boolean(v.Ready)}
+}
+
+func (v *flowVaultConnectionView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"APP", v.AppID},
+		{"ENVIRONMENT", v.Environment},
+		{"ACCOUNT", v.AccountName},
+		{"READY", boolean(v.Ready)},
+		{"CREATED", v.CreatedAt},
+	}
+}
+
+func (v *flowVaultConnectionView) Object() interface{} {
+	return &flowVaultConnectionObject{
+		ID:          v.raw.ID,
+		Name:        v.raw.Name,
+		AppID:       v.raw.AppID,
+		Environment: v.raw.Environment,
+		AccountName: v.raw.AccountName,
+		Ready:       v.raw.Ready,
+		Fingerprint: v.raw.Fingerprint,
+		CreatedAt:   v.raw.CreatedAt,
+		UpdatedAt:   v.raw.UpdatedAt,
+		RefreshedAt: v.raw.RefreshedAt,
+	}
+}
+
+func (r *Renderer) FlowVaultConnectionList(connections []*management.FlowVaultConnection) {
+	resource := "flow vault connections"
+
+	r.Heading(resource)
+
+	if len(connections) == 0 {
+		r.EmptyState(resource, "Connect apps to your flows from the Auth0 Dashboard")
+		return
+	}
+
+	var res []View
+	for _, connection := range connections {
+		view := &flowVaultConnectionView{
+			ID:          connection.GetID(),
+			Name:        connection.GetName(),
+			AppID:       connection.GetAppID(),
+			Environment: connection.GetEnvironment(),
+			AccountName: connection.GetAccountName(),
+			Ready:       connection.GetReady(),
+			raw:         connection,
+		}
+		if connection.CreatedAt != nil {
+			view.CreatedAt = timeAgo(connection.GetCreatedAt())
+		}
+		res = append(res, view)
+	}
+
+	r.Results(res)
+}
@@ -0,0 +1,182 @@
+package display
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type formView struct {
+	ID              string
+	Name            string
+	PrimaryLanguage string
+	Nodes           string
+	CreatedAt       string
+	UpdatedAt       string
+	SubmittedAt     string
+	raw             *management.Form
+}
+
+// formObject is the JSON output of a form, since
+// management.Form omits its read-only fields when marshaled.
+type formObject struct {
+	ID           *string                   `json:"id,omitempty"`
+	Name         *string                   `json:"name,omitempty"`
+	Messages     *management.FormMessages  `json:"messages,omitempty"`
+	Languages    *management.FormLanguages `json:"languages,omitempty"`
+	Translations *map[string]interface{}   `json:"translations,omitempty"`
+	Start        *map[string]interface{}   `json:"start,omitempty"`
+	Nodes        []interface{}             `json:"nodes,omitempty"`
+	Ending       *map[string]interface{}   `json:"ending,omitempty"`
+	Style        *map[string]interface{}   `json:"style,omitempty"`
+	CreatedAt    *time.Time                `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time                `json:"updated_at,omitempty"`
+	EmbeddedAt   *time.Time                `json:"embedded_at,omitempty"`
+	SubmittedAt  *time.Time                `json:"submitted_at,omitempty"`
+}
+
+func (v *formView) AsTableHeader() []string {
+	return []string{"ID", "Name", "Updated"}
+}
+
+func (v *formView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ID), v.Name, v.UpdatedAt}
+}
+
+func (v *formView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"PRIMARY LANGUAGE", v.PrimaryLanguage},
+		{"NODES", v.Nodes},
+		{"CREATED", v.CreatedAt},
+		{"UPDATED", v.UpdatedAt},
+		{"SUBMITTED", v.SubmittedAt},
+	}
+}
+
+func (v *formView) Object() interface{} {
+	return &formObject{
+		ID:           v.raw.ID,
+		Name:         v.raw.Name,
+		Messages:     v.raw.Messages,
+		Languages:    v.raw.Languages,
+		Translations: v.raw.Translations,
+		Start:        v.raw.Start,
+		Nodes:        v.raw.Nodes,
+		Ending:       v.raw.Ending,
+		Style:        v.raw.Style,
+		CreatedAt:    v.raw.CreatedAt,
+		UpdatedAt:    v.raw.UpdatedAt,
+		EmbeddedAt:   v.raw.EmbeddedAt,
+		SubmittedAt:  v.raw.SubmittedAt,
+	}
+}
+
+func (r *Renderer) FormList(forms []*management.Form) {
+	resource := "forms"
+
+	r.Heading(resource)
+
+	if len(forms) == 0 {
+		r.EmptyState(resource, "Use 'auth0 forms create' to add one")
+		return
+	}
+
+	var res []View
+	for _, form := range forms {
+		res = append(res, makeFormView(form))
+	}
+
+	r.Results(res)
+}
+
+func (r *Renderer) FormShow(form *management.Form) {
+	r.Heading("form")
+	r.Result(makeFormView(form))
+}
+
+func (r *Renderer) FormCreate(form *management.Form) {
+	r.Heading("form created")
+	r.Result(makeFormView(form))
+}
+
+func (r *Renderer) FormUpdate(form *management.Form) {
+	r.Heading("form updated")
+	r.Result(makeFormView(form))
+}
+
+func makeFormView(form *management.Form) *formView {
+	view := &formView{
+		ID:              form.GetID(),
+		Name:            form.GetName(),
+		PrimaryLanguage: form.GetLanguages().GetPrimary(),
+		Nodes:           strconv.Itoa(len(form.Nodes)),
+		raw:             form,
+	}
+
+	if form.CreatedAt != nil {
+		view.CreatedAt = timeAgo(form.GetCreatedAt())
+	}
+	if form.UpdatedAt != nil {
+		view.UpdatedAt = timeAgo(form.GetUpdatedAt())
+	}
+	if form.SubmittedAt != nil {
+		view.SubmittedAt = timeAgo(form.GetSubmittedAt())
+	}
+
+	return view
+}
+
+// DefinitionFile is a definition file synced with the tenant
+// by the pull and push commands of forms and flows.
+type DefinitionFile struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Action string `json:"action"`
+}
+
+type definitionFileView struct {
+	DefinitionFile
+}
+
+func (v *definitionFileView) AsTableHeader() []string {
+	return []string{"ID", "Name", "File", "Action"}
+}
+
+func (v *definitionFileView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ID), v.Name, v.Path, v.Action}
+}
+
+func (v *definitionFileView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"FILE", v.Path},
+		{"ACTION", v.Action},
+	}
+}
+
+func (v *definitionFileView) Object() interface{} {
+	return v.DefinitionFile
+}
+
+func (r *Renderer) DefinitionFiles(resource, action string, files []DefinitionFile) {
+	r.Heading(resource + " " + action)
+
+	if len(files) == 0 {
+		r.EmptyState(resource, "")
+		return
+	}
+
+	var res []View
+	for _, file := range files {
+		res = append(res, &definitionFileView{file})
+	}
+
+	r.Results(res)
+}
@@ -0,0 +1,169 @@
+package display
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type userJobView struct {
+	ID           string
+	Type         string
+	Status       string
+	ConnectionID string
+	CreatedAt    string
+	Progress     string
+	Total        string
+	Inserted     string
+	Updated      string
+	Failed       string
+	raw          interface{}
+}
+
+func (v *userJobView) AsTableHeader() []string {
+	return []string{"ID", "Type", "Status", "Total", "Inserted", "Updated", "Failed"}
+}
+
+func (v *userJobView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ID), v.Type, v.Status, v.Total, v.Inserted, v.Updated, v.Failed}
+}
+
+func (v *userJobView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"TYPE", v.Type},
+		{"STATUS", v.Status},
+		{"CONNECTION ID", v.ConnectionID},
+		{"CREATED", v.CreatedAt},
+		{"PROGRESS", v.Progress},
+		{"TOTAL", v.Total},
+		{"INSERTED", v.Inserted},
+		{"UPDATED", v.Updated},
+		{"FAILED", v.Failed},
+	}
+}
+
+func (v *userJobView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) UserJobShow(job *management.Job) {
+	r.Heading("job")
+	r.Result(makeUserJobView(job))
+}
+
+func (r *Renderer) UserJobList(jobs []*management.Job) {
+	resource := "jobs"
+
+	r.Heading(fmt.Sprintf("%s (%d)", resource, len(jobs)))
+
+	if len(jobs) == 0 {
+		r.EmptyState(resource, "")
+		return
+	}
+
+	var res []View
+	for _, job := range jobs {
+		res = append(res, makeUserJobView(job))
+	}
+
+	r.Results(res)
+}
+
+func makeUserJobView(job *management.Job) *userJobView {
+	summary := job.GetSummary()
+
+	view := &userJobView{
+		ID:           job.GetID(),
+		Type:         job.GetType(),
+		Status:       job.GetStatus(),
+		ConnectionID: job.GetConnectionID(),
+		Progress:     fmt.Sprintf("%d%%", job.GetPercentageDone()),
+		Total:        strconv.Itoa(summary.GetTotal()),
+		Inserted:     strconv.Itoa(summary.GetInserted()),
+		Updated:      strconv.Itoa(summary.GetUpdated()),
+		Failed:       strconv.Itoa(summary.GetFailed()),
+		raw:          job,
+	}
+
+	if job.CreatedAt != nil {
+		view.CreatedAt = timeAgo(job.GetCreatedAt())
+	}
+
+	if summary.GetFailed() > 0 {
+		view.Failed = ansi.Red(view.Failed)
+	}
+
+	return view
+}
+
+type userJobErrorView struct {
+	User    string
+	Code    string
+	Path    string
+	Message string
+	raw     interface{}
+}
+
+func (v *userJobErrorView) AsTableHeader() []string {
+	return []string{"User", "Code", "Path", "Message"}
+}
+
+func (v *userJobErrorView) AsTableRow() []string {
+	return []string{v.User, v.Code, v.Path, v.Message}
+}
+
+func (v *userJobErrorView) Object() interface{} {
+	return v.raw
+}
+
+// UserJobErrorList renders every error reported for the users
+// rejected by a job, one row per error.
+func (r *Renderer) UserJobErrorList(jobErrors []management.JobError) {
+	resource := "job errors"
+
+	switch r.Format {
+	case OutputFormatJSON:
+		r.JSONResult(jobErrors)
+		return
+	case OutputFormatJSONCompact:
+		r.JSONCompactResult(jobErrors)
+		return
+	}
+
+	r.Heading(fmt.Sprintf("%s (%d)", resource, len(jobErrors)))
+
+	if len(jobErrors) == 0 {
+		r.EmptyState(resource, "The job did not reject any user")
+		return
+	}
+
+	var res []View
+	for _, jobError := range jobErrors {
+		user := userJobErrorIdentifier(jobError.User)
+
+		for _, userError := range jobError.Errors {
+			res = append(res, &userJobErrorView{
+				User:    user,
+				Code:    userError.Code,
+				Path:    userError.Path,
+				Message: userError.Message,
+				raw:     jobError,
+			})
+		}
+	}
+
+	r.Results(res)
+}
+
+func userJobErrorIdentifier(user map[string]interface{}) string {
+	for _, key := range []string{"email", "user_id", "username", "phone_number"} {
+		if value, ok := user[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
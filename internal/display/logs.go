@@ -3,6 +3,7 @@ package display
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/auth0/go-auth0/management"
 
@@ -31,6 +32,14 @@ const (
 
 type logCategory int
 
+// LogCategories are the categories of log types, see LogCategory.
+var LogCategories = []string{
+	logCategorySuccess.String(),
+	logCategoryWarning.String(),
+	logCategoryFailure.String(),
+	logCategoryUnknown.String(),
+}
+
 var _ View = &LogView{}
 
 type LogView struct {
@@ -111,6 +120,31 @@ func (v *LogView) AsTableRow() []string {
 	}
 }
 
+// AsCSVHeader returns the header of the rows exported by AsCSVRow.
+func (v *LogView) AsCSVHeader() []string {
+	return append([]string{"Log ID"}, v.AsTableHeader()...)
+}
+
+// AsCSVRow returns the fields of the table row, without colors nor
+// truncation, along with the log ID and the full date of the log.
+func (v *LogView) AsCSVRow() []string {
+	chunks := strings.Split(v.TypeName(), "(")
+
+	desc := auth0.StringValue(v.Description)
+	if len(chunks) == 2 {
+		desc = strings.TrimSpace(strings.TrimSuffix(chunks[1], ")") + " " + desc)
+	}
+
+	return []string{
+		v.GetLogID(),
+		strings.TrimSpace(chunks[0]),
+		desc,
+		v.GetDate().Format(time.RFC3339Nano),
+		v.getConnection(),
+		v.GetClientName(),
+	}
+}
+
 func (v *LogView) Object() interface{} {
 	return v.raw
 }
@@ -143,6 +177,24 @@ func (v *LogView) category() logCategory {
 	}
 }
 
+// LogCategory returns the category of the log type, such as success or failure.
+func LogCategory(log *management.Log) string {
+	return (&LogView{Log: log}).category().String()
+}
+
+func (c logCategory) String() string {
+	switch c {
+	case logCategorySuccess:
+		return "success"
+	case logCategoryWarning:
+		return "warning"
+	case logCategoryFailure:
+		return "failure"
+	default:
+		return "unknown"
+	}
+}
+
 func (v *LogView) typeDesc() (typ, desc string) {
 	chunks := strings.Split(v.TypeName(), "(")
 
@@ -161,18 +213,7 @@ func (v *LogView) typeDesc() (typ, desc string) {
 
 	desc = fmt.Sprintf("%s %s", desc, auth0.StringValue(v.Description))
 
-	switch v.category() {
-	case logCategorySuccess:
-		typ = ansi.Green(typ)
-	case logCategoryFailure:
-		typ = ansi.BrightRed(typ)
-	case logCategoryWarning:
-		typ = ansi.BrightYellow(typ)
-	default:
-		typ = ansi.Faint(typ)
-	}
-
-	return typ, desc
+	return colorizeLogCategory(v.category(), typ), desc
 }
 
 func (r *Renderer) LogPrompt(logs []*management.Log, currentIndex *int) string {
@@ -291,3 +332,16 @@ func (r *Renderer) QuitPrompt() bool {
 
 	return rn == 'q' || rn == 'Q'
 }
+
+func colorizeLogCategory(category logCategory, value string) string {
+	switch category {
+	case logCategorySuccess:
+		return ansi.Green(value)
+	case logCategoryFailure:
+		return ansi.BrightRed(value)
+	case logCategoryWarning:
+		return ansi.BrightYellow(value)
+	default:
+		return ansi.Faint(value)
+	}
+}
@@ -0,0 +1,62 @@
+package display
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// LogAlert is raised when the logs matching an alerting rule exceed its threshold.
+type LogAlert struct {
+	Rule        string            `json:"rule"`
+	Description string            `json:"description,omitempty"`
+	Group       map[string]string `json:"group,omitempty"`
+	Count       int               `json:"count"`
+	Window      string            `json:"window"`
+	From        time.Time         `json:"from"`
+	To          time.Time         `json:"to"`
+	LogIDs      []string          `json:"log_ids"`
+}
+
+func (r *Renderer) LogAlert(alert LogAlert) {
+	switch r.Format {
+	case OutputFormatJSON:
+		r.JSONResult(alert)
+		return
+	case OutputFormatJSONCompact:
+		r.JSONCompactResult(alert)
+		return
+	}
+
+	keys := make([]string, 0, len(alert.Group))
+	for key := range alert.Group {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var group []string
+	for _, key := range keys {
+		group = append(group, fmt.Sprintf("%s=%s", key, alert.Group[key]))
+	}
+
+	line := fmt.Sprintf(
+		"%s  %s  %s  %d logs from %s to %s",
+		ansi.Faint(alert.To.Local().Format("Jan 02 15:04:05")),
+		ansi.Bold(ansi.BrightRed("ALERT")),
+		ansi.Bold(alert.Rule),
+		alert.Count,
+		alert.From.Local().Format("15:04:05"),
+		alert.To.Local().Format("15:04:05"),
+	)
+	if len(group) > 0 {
+		line += " " + ansi.Yellow(strings.Join(group, " "))
+	}
+	if alert.Description != "" {
+		line += "  " + ansi.Faint(alert.Description)
+	}
+
+	fmt.Fprintln(r.ResultWriter, line)
+}
@@ -0,0 +1,235 @@
+package display
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// LogDimensions are the log fields that logs can be grouped by, see LogDimension.
+var LogDimensions = []string{
+	"type",
+	"description",
+	"category",
+	"client_name",
+	"client_id",
+	"connection",
+	"ip",
+	"user_name",
+	"user_id",
+}
+
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+// LogStat is the number of logs sharing the same values for the grouped by dimensions.
+type LogStat struct {
+	Group    map[string]string `json:"group"`
+	Count    int               `json:"count"`
+	Buckets  []int             `json:"buckets"`
+	category logCategory
+}
+
+// LogStats aggregates the logs of a time window, split in buckets of the same duration.
+type LogStats struct {
+	GroupBy    []string      `json:"group_by"`
+	From       time.Time     `json:"from"`
+	To         time.Time     `json:"to"`
+	BucketSize time.Duration `json:"-"`
+	Total      int           `json:"total"`
+	Stats      []LogStat     `json:"stats"`
+}
+
+type logStatView struct {
+	stat    LogStat
+	groupBy []string
+	total   int
+}
+
+// AggregateLogStats counts the logs by the values of the grouped by dimensions,
+// splitting the time window in buckets. The stats are ranked by decreasing count.
+func AggregateLogStats(logs []*management.Log, groupBy []string, from, to time.Time, buckets int) LogStats {
+	stats := LogStats{
+		GroupBy:    groupBy,
+		From:       from,
+		To:         to,
+		BucketSize: to.Sub(from) / time.Duration(buckets),
+		Stats:      []LogStat{},
+	}
+
+	indexByKey := make(map[string]int)
+	for _, log := range logs {
+		view := &LogView{Log: log}
+
+		values := make([]string, len(groupBy))
+		for i, dimension := range groupBy {
+			values[i] = view.dimension(dimension)
+		}
+
+		key := strings.Join(values, "\x00")
+		index, ok := indexByKey[key]
+		if !ok {
+			group := make(map[string]string, len(groupBy))
+			for i, dimension := range groupBy {
+				group[dimension] = values[i]
+			}
+
+			index = len(stats.Stats)
+			indexByKey[key] = index
+			stats.Stats = append(stats.Stats, LogStat{
+				Group:    group,
+				Buckets:  make([]int, buckets),
+				category: view.category(),
+			})
+		}
+
+		stats.Stats[index].Count++
+		stats.Total++
+
+		if stats.BucketSize > 0 {
+			bucket := int(log.GetDate().Sub(from) / stats.BucketSize)
+			if bucket >= 0 && bucket < buckets {
+				stats.Stats[index].Buckets[bucket]++
+			}
+		}
+	}
+
+	sort.SliceStable(stats.Stats, func(i, j int) bool {
+		return stats.Stats[i].Count > stats.Stats[j].Count
+	})
+
+	return stats
+}
+
+// LogDimension returns the value of one of the LogDimensions of the log, or N/A if it's empty.
+func LogDimension(log *management.Log, name string) string {
+	return (&LogView{Log: log}).dimension(name)
+}
+
+func (v *LogView) dimension(name string) string {
+	var value string
+
+	switch name {
+	case "type":
+		value = strings.TrimSpace(strings.Split(v.TypeName(), "(")[0])
+	case "description":
+		value = v.GetDescription()
+	case "category":
+		value = v.category().String()
+	case "client_name":
+		value = v.GetClientName()
+	case "client_id":
+		value = v.GetClientID()
+	case "connection":
+		value = v.GetConnection()
+	case "ip":
+		value = v.GetIP()
+	case "user_name":
+		value = v.GetUserName()
+	case "user_id":
+		value = v.GetUserID()
+	}
+
+	if value == "" {
+		return notApplicable
+	}
+
+	return value
+}
+
+func (v *logStatView) AsTableHeader() []string {
+	header := make([]string, 0, len(v.groupBy)+3)
+	for _, dimension := range v.groupBy {
+		header = append(header, strings.ReplaceAll(dimension, "_", " "))
+	}
+
+	return append(header, "Count", "Percent", "Trend")
+}
+
+func (v *logStatView) AsTableRow() []string {
+	row := make([]string, 0, len(v.groupBy)+3)
+	for _, dimension := range v.groupBy {
+		value := v.stat.Group[dimension]
+		switch {
+		case value == notApplicable:
+			value = ansi.Faint(value)
+		case dimension == "type" || dimension == "category":
+			value = colorizeLogCategory(v.stat.category, value)
+		}
+		row = append(row, value)
+	}
+
+	return append(
+		row,
+		fmt.Sprintf("%d", v.stat.Count),
+		fmt.Sprintf("%.1f%%", float64(v.stat.Count)*100/float64(v.total)),
+		sparkline(v.stat.Buckets),
+	)
+}
+
+func (v *logStatView) Object() interface{} {
+	return v.stat
+}
+
+func (r *Renderer) LogStats(stats LogStats) {
+	switch r.Format {
+	case OutputFormatJSON:
+		r.JSONResult(stats)
+		return
+	case OutputFormatJSONCompact:
+		r.JSONCompactResult(stats)
+		return
+	}
+
+	resource := "log stats"
+
+	r.Heading(resource)
+
+	if stats.Total == 0 {
+		r.EmptyState(resource, "No logs were found in the time window, try a larger one with --since")
+		return
+	}
+
+	var res []View
+	for _, stat := range stats.Stats {
+		res = append(res, &logStatView{stat: stat, groupBy: stats.GroupBy, total: stats.Total})
+	}
+
+	r.Results(res)
+
+	if r.Format != OutputFormatCSV {
+		r.Newline()
+		r.Infof(
+			"%s %d logs from %s to %s, in buckets of %s.",
+			ansi.Faint("Trend:"),
+			stats.Total,
+			stats.From.Local().Format("Jan 02 15:04:05"),
+			stats.To.Local().Format("Jan 02 15:04:05"),
+			stats.BucketSize.Round(time.Second),
+		)
+	}
+}
+
+// sparkline renders the counts as a line of bars scaled to the largest count.
+func sparkline(counts []int) string {
+	var maxCount int
+	for _, count := range counts {
+		maxCount = max(maxCount, count)
+	}
+
+	var line strings.Builder
+	for _, count := range counts {
+		tick := 0
+		if count > 0 {
+			// Any log is shown above the baseline, however small the count.
+			tick = max(1, count*(len(sparklineTicks)-1)/maxCount)
+		}
+		line.WriteRune(sparklineTicks[tick])
+	}
+
+	return line.String()
+}
@@ -0,0 +1,43 @@
+package display
+
+import (
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+func TestAggregateLogStats(t *testing.T) {
+	from := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	logs := []*management.Log{
+		{Type: auth0.String("f"), IP: auth0.String("10.0.0.1"), Date: auth0.Time(from.Add(5 * time.Minute))},
+		{Type: auth0.String("s"), IP: auth0.String("10.0.0.2"), Date: auth0.Time(from.Add(10 * time.Minute))},
+		{Type: auth0.String("f"), IP: auth0.String("10.0.0.1"), Date: auth0.Time(from.Add(50 * time.Minute))},
+		{Type: auth0.String("f"), Date: auth0.Time(from.Add(55 * time.Minute))},
+	}
+
+	stats := AggregateLogStats(logs, []string{"category", "ip"}, from, to, 4)
+
+	assert.Equal(t, 4, stats.Total)
+	assert.Equal(t, 15*time.Minute, stats.BucketSize)
+	require.Len(t, stats.Stats, 3)
+
+	assert.Equal(t, map[string]string{"category": "failure", "ip": "10.0.0.1"}, stats.Stats[0].Group)
+	assert.Equal(t, 2, stats.Stats[0].Count)
+	assert.Equal(t, []int{1, 0, 0, 1}, stats.Stats[0].Buckets)
+
+	assert.Equal(t, map[string]string{"category": "success", "ip": "10.0.0.2"}, stats.Stats[1].Group)
+	assert.Equal(t, map[string]string{"category": "failure", "ip": "N/A"}, stats.Stats[2].Group)
+}
+
+func TestSparkline(t *testing.T) {
+	assert.Equal(t, "▁▁▁", sparkline([]int{0, 0, 0}))
+	assert.Equal(t, "▁█▂", sparkline([]int{0, 10, 1}))
+	assert.Equal(t, "▄█", sparkline([]int{5, 10}))
+}
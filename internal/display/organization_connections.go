@@ -0,0 +1,77 @@
+package display
+
+import (
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type organizationConnectionView struct {
+	ID                      string
+	Name                    string
+	Strategy                string
+	AssignMembershipOnLogin string
+	ShowAsButton            string
+	raw                     interface{}
+}
+
+func (v *organizationConnectionView) AsTableHeader() []string {
+	return []string{"ID", "Name", "Strategy", "Assign Membership On Login", "Show As Button"}
+}
+
+func (v *organizationConnectionView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ID), v.Name, v.Strategy, v.AssignMembershipOnLogin, v.ShowAsButton}
+}
+
+func (v *organizationConnectionView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"STRATEGY", v.Strategy},
+		{"ASSIGN MEMBERSHIP ON LOGIN", v.AssignMembershipOnLogin},
+		{"SHOW AS BUTTON", v.ShowAsButton},
+	}
+}
+
+func (v *organizationConnectionView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) OrganizationConnectionList(connections []*management.OrganizationConnection) {
+	resource := "organization connections"
+
+	r.Heading(resource)
+
+	if len(connections) == 0 {
+		r.EmptyState(resource, "Use 'auth0 orgs connections enable' to add one")
+		return
+	}
+
+	var res []View
+	for _, connection := range connections {
+		res = append(res, makeOrganizationConnectionView(connection))
+	}
+
+	r.Results(res)
+}
+
+func (r *Renderer) OrganizationConnectionEnable(connection *management.OrganizationConnection) {
+	r.Heading("organization connection enabled")
+	r.Result(makeOrganizationConnectionView(connection))
+}
+
+func (r *Renderer) OrganizationConnectionUpdate(connection *management.OrganizationConnection) {
+	r.Heading("organization connection updated")
+	r.Result(makeOrganizationConnectionView(connection))
+}
+
+func makeOrganizationConnectionView(connection *management.OrganizationConnection) *organizationConnectionView {
+	return &organizationConnectionView{
+		ID:                      connection.GetConnectionID(),
+		Name:                    connection.GetConnection().GetName(),
+		Strategy:                connection.GetConnection().GetStrategy(),
+		AssignMembershipOnLogin: boolean(connection.GetAssignMembershipOnLogin()),
+		ShowAsButton:            boolean(connection.GetShowAsButton()),
+		raw:                     connection,
+	}
+}
@@ -0,0 +1,116 @@
+package display
+
+import (
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type discoveryDomainView struct {
+	ID               string
+	Domain           string
+	Status           string
+	UseForDiscovery  string
+	VerificationHost string
+	VerificationTXT  string
+	raw              interface{}
+}
+
+func (v *discoveryDomainView) AsTableHeader() []string {
+	return []string{"ID", "Domain", "Status", "Use For Discovery"}
+}
+
+func (v *discoveryDomainView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ID), v.Domain, v.Status, v.UseForDiscovery}
+}
+
+func (v *discoveryDomainView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"DOMAIN", v.Domain},
+		{"STATUS", v.Status},
+		{"USE FOR DISCOVERY", v.UseForDiscovery},
+		{"TXT RECORD HOST", v.VerificationHost},
+		{"TXT RECORD VALUE", v.VerificationTXT},
+	}
+}
+
+func (v *discoveryDomainView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) DiscoveryDomainList(domains []*management.OrganizationDiscoveryDomain) {
+	resource := "organization discovery domains"
+
+	r.Heading(resource)
+
+	if len(domains) == 0 {
+		r.EmptyState(resource, "Use 'auth0 orgs domains create' to add one")
+		return
+	}
+
+	var res []View
+	for _, domain := range domains {
+		res = append(res, makeDiscoveryDomainView(domain))
+	}
+
+	r.Results(res)
+}
+
+func (r *Renderer) DiscoveryDomainShow(domain *management.OrganizationDiscoveryDomain) {
+	r.Heading("organization discovery domain")
+	r.Result(makeDiscoveryDomainView(domain))
+	r.discoveryDomainVerificationHint(domain)
+}
+
+func (r *Renderer) DiscoveryDomainCreate(domain *management.OrganizationDiscoveryDomain) {
+	r.Heading("organization discovery domain created")
+	r.Result(makeDiscoveryDomainView(domain))
+	r.discoveryDomainVerificationHint(domain)
+}
+
+func (r *Renderer) DiscoveryDomainUpdate(domain *management.OrganizationDiscoveryDomain) {
+	r.Heading("organization discovery domain updated")
+	r.Result(makeDiscoveryDomainView(domain))
+}
+
+func (r *Renderer) DiscoveryDomainVerify(domain *management.OrganizationDiscoveryDomain) {
+	r.Heading("organization discovery domain verified")
+	r.Result(makeDiscoveryDomainView(domain))
+}
+
+func (r *Renderer) discoveryDomainVerificationHint(domain *management.OrganizationDiscoveryDomain) {
+	if domain.GetStatus() == "verified" {
+		return
+	}
+
+	r.Newline()
+	r.Infof("%s Publish a DNS TXT record on %s with the value %s, then run `auth0 orgs domains verify`.",
+		ansi.Faint("Hint:"),
+		ansi.Bold(domain.GetVerificationHost()),
+		ansi.Bold(domain.GetVerificationTXT()),
+	)
+}
+
+func makeDiscoveryDomainView(domain *management.OrganizationDiscoveryDomain) *discoveryDomainView {
+	return &discoveryDomainView{
+		ID:               domain.GetID(),
+		Domain:           domain.GetDomain(),
+		Status:           colorizeDiscoveryDomainStatus(domain.GetStatus()),
+		UseForDiscovery:  boolean(domain.GetUseForOrganizationDiscovery()),
+		VerificationHost: domain.GetVerificationHost(),
+		VerificationTXT:  domain.GetVerificationTXT(),
+		raw:              domain,
+	}
+}
+
+func colorizeDiscoveryDomainStatus(status string) string {
+	switch status {
+	case "verified":
+		return ansi.Green(status)
+	case "pending":
+		return ansi.Yellow(status)
+	default:
+		return status
+	}
+}
@@ -0,0 +1,78 @@
+package display
+
+import (
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// OrganizationProvisionUnchanged is the action of the resources that already match the manifest.
+const OrganizationProvisionUnchanged = "unchanged"
+
+// OrganizationProvisionAction is an action taken, or planned with --dry-run,
+// to reconcile an organization with its manifest.
+type OrganizationProvisionAction struct {
+	Resource string `json:"resource"`
+	Target   string `json:"target"`
+	Action   string `json:"action"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+type organizationProvisionActionView struct {
+	Resource string
+	Target   string
+	Action   string
+	Detail   string
+	raw      interface{}
+}
+
+func (v *organizationProvisionActionView) AsTableHeader() []string {
+	return []string{"Resource", "Target", "Action", "Detail"}
+}
+
+func (v *organizationProvisionActionView) AsTableRow() []string {
+	return []string{v.Resource, v.Target, v.Action, v.Detail}
+}
+
+func (v *organizationProvisionActionView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) OrganizationProvision(actions []OrganizationProvisionAction, dryRun bool) {
+	resource := "organization provisioning actions"
+	if dryRun {
+		resource = "organization provisioning plan"
+	}
+
+	r.Heading(resource)
+
+	if len(actions) == 0 {
+		r.EmptyState(resource, "")
+		return
+	}
+
+	var res []View
+	for _, action := range actions {
+		res = append(res, makeOrganizationProvisionActionView(action, dryRun))
+	}
+
+	r.Results(res)
+}
+
+func makeOrganizationProvisionActionView(action OrganizationProvisionAction, dryRun bool) *organizationProvisionActionView {
+	name := action.Action
+	switch {
+	case name == OrganizationProvisionUnchanged:
+		name = ansi.Faint(name)
+	case dryRun:
+		name = ansi.Yellow("would " + name)
+	default:
+		name = ansi.Green(name)
+	}
+
+	return &organizationProvisionActionView{
+		Resource: action.Resource,
+		Target:   action.Target,
+		Action:   name,
+		Detail:   action.Detail,
+		raw:      action,
+	}
+}
@@ -0,0 +1,71 @@
+package display
+
+import (
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/config"
+)
+
+type profileView struct {
+	Active bool
+	config.Profile
+}
+
+type profileObject struct {
+	config.Profile
+	Active bool `json:"active"`
+}
+
+func (v *profileView) AsTableHeader() []string {
+	return []string{"Active", "Name", "Tenant", "Default App", "Default Audience", "Output"}
+}
+
+func (v *profileView) AsTableRow() []string {
+	activeText := ""
+	if v.Active {
+		activeText = ansi.Green("→")
+	}
+
+	return []string{
+		activeText,
+		v.Name,
+		v.Tenant,
+		v.DefaultAppID,
+		v.DefaultAudience,
+		v.Output,
+	}
+}
+
+func (v *profileView) KeyValues() [][]string {
+	return [][]string{
+		{"NAME", v.Name},
+		{"TENANT", v.Tenant},
+		{"DEFAULT APP", v.DefaultAppID},
+		{"DEFAULT AUDIENCE", v.DefaultAudience},
+		{"OUTPUT", v.Output},
+		{"NO COLOR", boolean(v.NoColor)},
+	}
+}
+
+func (v *profileView) Object() interface{} {
+	return profileObject{Profile: v.Profile, Active: v.Active}
+}
+
+// ProfileList renders the profiles, marking the one in use.
+func (r *Renderer) ProfileList(profiles []config.Profile, active string) {
+	if len(profiles) == 0 {
+		r.EmptyState("profiles", "Use 'auth0 profiles create' to add one")
+		return
+	}
+
+	results := make([]View, 0, len(profiles))
+	for _, profile := range profiles {
+		results = append(results, &profileView{Active: profile.Name == active, Profile: profile})
+	}
+
+	r.Results(results)
+}
+
+func (r *Renderer) ProfileCreate(profile config.Profile) {
+	r.Heading("profile created")
+	r.Result(&profileView{Profile: profile})
+}
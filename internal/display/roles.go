@@ -74,6 +74,23 @@ func (r *Renderer) UserRoleList(roles []*management.Role) {
 	r.Results(res)
 }
 
+func (r *Renderer) OrganizationMemberRoleList(roles []*management.Role) {
+	resource := "organization member roles"
+	r.Heading(fmt.Sprintf("%s (%d)", resource, len(roles)))
+
+	if len(roles) == 0 {
+		r.EmptyState(resource, "Use 'auth0 orgs members roles assign' to assign roles to a member")
+		return
+	}
+
+	var res []View
+	for _, role := range roles {
+		res = append(res, makeRoleView(role))
+	}
+
+	r.Results(res)
+}
+
 func (r *Renderer) RoleShow(role *management.Role) {
 	r.Heading("role")
 	r.Result(makeRoleView(role))
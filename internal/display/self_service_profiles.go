@@ -0,0 +1,168 @@
+package display
+
+import (
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type selfServiceProfileView struct {
+	ID                     string
+	Name                   string
+	Description            string
+	AllowedStrategies      []string
+	UserAttributes         []string
+	UserAttributeProfileID string
+	LogoURL                string
+	PrimaryColor           string
+	CreatedAt              string
+	UpdatedAt              string
+	raw                    *management.SelfServiceProfile
+}
+
+// selfServiceProfileObject is the JSON output of a self-service profile, since
+// management.SelfServiceProfile omits its read-only fields when marshaled.
+type selfServiceProfileObject struct {
+	ID                     *string                                        `json:"id,omitempty"`
+	Name                   *string                                        `json:"name,omitempty"`
+	Description            *string                                        `json:"description,omitempty"`
+	AllowedStrategies      *[]string                                      `json:"allowed_strategies,omitempty"`
+	UserAttributes         []*management.SelfServiceProfileUserAttributes `json:"user_attributes,omitempty"`
+	UserAttributeProfileID *string                                        `json:"user_attribute_profile_id,omitempty"`
+	Branding               *management.Branding                           `json:"branding,omitempty"`
+	CreatedAt              *time.Time                                     `json:"created_at,omitempty"`
+	UpdatedAt              *time.Time                                     `json:"updated_at,omitempty"`
+}
+
+func (v *selfServiceProfileView) AsTableHeader() []string {
+	return []string{"ID", "Name", "Description", "Allowed Strategies"}
+}
+
+func (v *selfServiceProfileView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ID), v.Name, v.Description, strings.Join(v.AllowedStrategies, ", ")}
+}
+
+func (v *selfServiceProfileView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"DESCRIPTION", v.Description},
+		{"ALLOWED STRATEGIES", strings.Join(v.AllowedStrategies, ", ")},
+		{"USER ATTRIBUTES", strings.Join(v.UserAttributes, ", ")},
+		{"USER ATTRIBUTE PROFILE ID", v.UserAttributeProfileID},
+		{"LOGO URL", v.LogoURL},
+		{"PRIMARY COLOR", v.PrimaryColor},
+		{"CREATED", v.CreatedAt},
+		{"UPDATED", v.UpdatedAt},
+	}
+}
+
+func (v *selfServiceProfileView) Object() interface{} {
+	return &selfServiceProfileObject{
+		ID:                     v.raw.ID,
+		Name:                   v.raw.Name,
+		Description:            v.raw.Description,
+		AllowedStrategies:      v.raw.AllowedStrategies,
+		UserAttributes:         v.raw.UserAttributes,
+		UserAttributeProfileID: v.raw.UserAttributeProfileID,
+		Branding:               v.raw.Branding,
+		CreatedAt:              v.raw.CreatedAt,
+		UpdatedAt:              v.raw.UpdatedAt,
+	}
+}
+
+func (r *Renderer) SelfServiceProfileList(profiles []*management.SelfServiceProfile) {
+	resource := "self-service profiles"
+
+	r.Heading(resource)
+
+	if len(profiles) == 0 {
+		r.EmptyState(resource, "Use 'auth0 self-service-profiles create' to add one")
+		return
+	}
+
+	var res []View
+	for _, profile := range profiles {
+		res = append(res, makeSelfServiceProfileView(profile))
+	}
+
+	r.Results(res)
+}
+
+func (r *Renderer) SelfServiceProfileShow(profile *management.SelfServiceProfile) {
+	r.Heading("self-service profile")
+	r.Result(makeSelfServiceProfileView(profile))
+}
+
+func (r *Renderer) SelfServiceProfileCreate(profile *management.SelfServiceProfile) {
+	r.Heading("self-service profile created")
+	r.Result(makeSelfServiceProfileView(profile))
+}
+
+func (r *Renderer) SelfServiceProfileUpdate(profile *management.SelfServiceProfile) {
+	r.Heading("self-service profile updated")
+	r.Result(makeSelfServiceProfileView(profile))
+}
+
+func makeSelfServiceProfileView(profile *management.SelfServiceProfile) *selfServiceProfileView {
+	var userAttributes []string
+	for _, attribute := range profile.UserAttributes {
+		name := attribute.GetName()
+		if attribute.GetIsOptional() {
+			name += " (optional)"
+		}
+		userAttributes = append(userAttributes, name)
+	}
+
+	view := &selfServiceProfileView{
+		ID:                     profile.GetID(),
+		Name:                   profile.GetName(),
+		Description:            profile.GetDescription(),
+		AllowedStrategies:      profile.GetAllowedStrategies(),
+		UserAttributes:         userAttributes,
+		UserAttributeProfileID: profile.GetUserAttributeProfileID(),
+		LogoURL:                profile.GetBranding().GetLogoURL(),
+		PrimaryColor:           profile.GetBranding().GetColors().GetPrimary(),
+		raw:                    profile,
+	}
+
+	if profile.CreatedAt != nil {
+		view.CreatedAt = timeAgo(profile.GetCreatedAt())
+	}
+	if profile.UpdatedAt != nil {
+		view.UpdatedAt = timeAgo(profile.GetUpdatedAt())
+	}
+
+	return view
+}
+
+type selfServiceProfileTicketView struct {
+	Ticket string
+}
+
+func (v *selfServiceProfileTicketView) AsTableHeader() []string {
+	return []string{"Ticket"}
+}
+
+func (v *selfServiceProfileTicketView) AsTableRow() []string {
+	return []string{v.Ticket}
+}
+
+func (v *selfServiceProfileTicketView) KeyValues() [][]string {
+	return [][]string{
+		{"TICKET", v.Ticket},
+	}
+}
+
+func (v *selfServiceProfileTicketView) Object() interface{} {
+	return map[string]string{"ticket": v.Ticket}
+}
+
+func (r *Renderer) SelfServiceProfileTicket(ticket *management.SelfServiceProfileTicket) {
+	r.Heading("self-service SSO ticket created")
+	r.Result(&selfServiceProfileTicketView{Ticket: ticket.GetTicket()})
+	r.Infof("Share the ticket URL with the customer so they can set up their SSO connection.")
+}
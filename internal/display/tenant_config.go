@@ -0,0 +1,79 @@
+package display
+
+import (
+	"fmt"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// TenantChange is a change to apply to a tenant so
+// that it matches its declarative configuration.
+type TenantChange struct {
+	Action   string `json:"action"`
+	Resource string `json:"resource"`
+	Key      string `json:"key"`
+}
+
+type tenantChangeView struct {
+	Action   string
+	Resource string
+	Key      string
+	raw      interface{}
+}
+
+func (v *tenantChangeView) AsTableHeader() []string {
+	return []string{"Action", "Resource", "Key"}
+}
+
+func (v *tenantChangeView) AsTableRow() []string {
+	return []string{v.Action, v.Resource, v.Key}
+}
+
+func (v *tenantChangeView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) TenantPlan(changes []TenantChange) {
+	resource := "changes"
+
+	r.Heading(fmt.Sprintf("%s (%d)", resource, len(changes)))
+
+	if len(changes) == 0 {
+		r.EmptyState(resource, "The tenant already matches the configuration.")
+		return
+	}
+
+	var res []View
+	for _, change := range changes {
+		res = append(res, &tenantChangeView{
+			Action:   colorizeTenantChangeAction(change.Action),
+			Resource: change.Resource,
+			Key:      change.Key,
+			raw:      change,
+		})
+	}
+
+	r.Results(res)
+}
+
+func colorizeTenantChangeAction(action string) string {
+	switch action {
+	case "create":
+		return ansi.Green(action)
+	case "update":
+		return ansi.Yellow(action)
+	case "delete":
+		return ansi.Red(action)
+	default:
+		return action
+	}
+}
+
+// TenantResourceDiff is a resource that differs between two tenants.
+type TenantResourceDiff struct {
+	Resource string                 `json:"resource"`
+	Key      string                 `json:"key"`
+	Status   string                 `json:"status"`
+	From     map[string]interface{} `json:"from,omitempty"`
+	To       map[string]interface{} `json:"to,omitempty"`
+}
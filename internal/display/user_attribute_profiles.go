@@ -0,0 +1,118 @@
+package display
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type userAttributeProfileView struct {
+	ID             string
+	Name           string
+	UserIDMappings []string
+	UserAttributes []string
+	raw            *management.UserAttributeProfile
+}
+
+// userAttributeProfileObject is the JSON output of a user attribute profile,
+// since management.UserAttributeProfile omits its ID when marshaled.
+type userAttributeProfileObject struct {
+	ID             *string                                                   `json:"id,omitempty"`
+	Name           *string                                                   `json:"name,omitempty"`
+	UserID         *management.UserAttributeProfileUserID                    `json:"user_id,omitempty"`
+	UserAttributes map[string]*management.UserAttributeProfileUserAttributes `json:"user_attributes,omitempty"`
+}
+
+func (v *userAttributeProfileView) AsTableHeader() []string {
+	return []string{"ID", "Name", "User Attributes"}
+}
+
+func (v *userAttributeProfileView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ID), v.Name, strings.Join(v.UserAttributes, ", ")}
+}
+
+func (v *userAttributeProfileView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"USER ID MAPPINGS", strings.Join(v.UserIDMappings, ", ")},
+		{"USER ATTRIBUTES", strings.Join(v.UserAttributes, ", ")},
+	}
+}
+
+func (v *userAttributeProfileView) Object() interface{} {
+	return &userAttributeProfileObject{
+		ID:             v.raw.ID,
+		Name:           v.raw.Name,
+		UserID:         v.raw.UserID,
+		UserAttributes: v.raw.UserAttributes,
+	}
+}
+
+func (r *Renderer) UserAttributeProfileList(profiles []*management.UserAttributeProfile) {
+	resource := "user attribute profiles"
+
+	r.Heading(resource)
+
+	if len(profiles) == 0 {
+		r.EmptyState(resource, "Use 'auth0 user-attribute-profiles create' to add one")
+		return
+	}
+
+	var res []View
+	for _, profile := range profiles {
+		res = append(res, makeUserAttributeProfileView(profile))
+	}
+
+	r.Results(res)
+}
+
+func (r *Renderer) UserAttributeProfileShow(profile *management.UserAttributeProfile) {
+	r.Heading("user attribute profile")
+	r.Result(makeUserAttributeProfileView(profile))
+}
+
+func (r *Renderer) UserAttributeProfileCreate(profile *management.UserAttributeProfile) {
+	r.Heading("user attribute profile created")
+	r.Result(makeUserAttributeProfileView(profile))
+}
+
+func (r *Renderer) UserAttributeProfileUpdate(profile *management.UserAttributeProfile) {
+	r.Heading("user attribute profile updated")
+	r.Result(makeUserAttributeProfileView(profile))
+}
+
+func makeUserAttributeProfileView(profile *management.UserAttributeProfile) *userAttributeProfileView {
+	var userIDMappings []string
+	if userID := profile.UserID; userID != nil {
+		if userID.OIDCMapping != nil {
+			userIDMappings = append(userIDMappings, "oidc: "+userID.GetOIDCMapping())
+		}
+		if userID.SAMLMapping != nil {
+			userIDMappings = append(userIDMappings, "saml: "+strings.Join(userID.GetSAMLMapping(), " "))
+		}
+		if userID.SCIMMapping != nil {
+			userIDMappings = append(userIDMappings, "scim: "+userID.GetSCIMMapping())
+		}
+	}
+
+	var userAttributes []string
+	for name, attribute := range profile.UserAttributes {
+		if attribute.GetProfileRequired() {
+			name += " (required)"
+		}
+		userAttributes = append(userAttributes, name)
+	}
+	sort.Strings(userAttributes)
+
+	return &userAttributeProfileView{
+		ID:             profile.GetID(),
+		Name:           profile.GetName(),
+		UserIDMappings: userIDMappings,
+		UserAttributes: userAttributes,
+		raw:            profile,
+	}
+}
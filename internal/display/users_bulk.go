@@ -0,0 +1,48 @@
+package display
+
+const (
+	UserBulkOutcomeSucceeded = "succeeded"
+	UserBulkOutcomeFailed    = "failed"
+)
+
+// UserBulkOutcome is the outcome of an operation run on one user of a bulk
+// run, such as the job or the ticket created for the user.
+type UserBulkOutcome struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email,omitempty"`
+	Status string `json:"status"`
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type userBulkOutcomeView struct {
+	UserBulkOutcome
+	resultLabel string
+}
+
+func (v *userBulkOutcomeView) AsTableHeader() []string {
+	return []string{"User ID", "Email", "Status", v.resultLabel, "Error"}
+}
+
+// AsTableRow doesn't colorize the row, so that the outcome
+// can be written to a CSV file as is.
+func (v *userBulkOutcomeView) AsTableRow() []string {
+	return []string{v.UserID, v.Email, v.Status, v.Result, v.Error}
+}
+
+func (v *userBulkOutcomeView) Object() interface{} {
+	return v.UserBulkOutcome
+}
+
+// UserBulkOutcomes renders the outcome of a bulk run for each user. The result
+// label names what the operation produced, such as a job ID or a ticket URL.
+func (r *Renderer) UserBulkOutcomes(heading, resultLabel string, outcomes []UserBulkOutcome) {
+	r.Heading(heading)
+
+	res := make([]View, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		res = append(res, &userBulkOutcomeView{UserBulkOutcome: outcome, resultLabel: resultLabel})
+	}
+
+	r.Results(res)
+}
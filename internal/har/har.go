@@ -0,0 +1,268 @@
+// Package har records HTTP requests and responses to HAR (HTTP Archive) files,
+// and replays the responses of such recordings.
+package har
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+)
+
+const version = "1.2"
+
+// Redacted replaces credentials and secrets in the recordings.
+const Redacted = "REDACTED"
+
+var (
+	// Headers carrying credentials, whose values are redacted.
+	redactedHeaders = map[string]bool{
+		"Authorization": true,
+		"Cookie":        true,
+		"Set-Cookie":    true,
+	}
+
+	// Suffixes of the names of JSON fields holding secrets, such as client_secret,
+	// api_key, splunkToken or the ticket URL of a password change, once lower-cased
+	// and stripped of "_" and "-".
+	redactedFieldSuffixes = []string{
+		"apikey",
+		"authorization",
+		"password",
+		"privatekey",
+		"smtppass",
+		"ticket",
+		"token",
+	}
+)
+
+// HAR is the root of a HAR file.
+type HAR struct {
+	Log Log `json:"log"`
+}
+
+// Log holds the recorded entries.
+type Log struct {
+	Version string  `json:"version"`
+	Creator Creator `json:"creator"`
+	Entries []Entry `json:"entries"`
+}
+
+// Creator is the application that recorded the entries.
+type Creator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Entry is a request and the response it got.
+type Entry struct {
+	StartedDateTime string   `json:"startedDateTime"`
+	Time            float64  `json:"time"`
+	Request         Request  `json:"request"`
+	Response        Response `json:"response"`
+	Cache           struct{} `json:"cache"`
+	Timings         Timings  `json:"timings"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []NameValue `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	QueryString []NameValue `json:"queryString"`
+	PostData    *PostData   `json:"postData,omitempty"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []NameValue `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	Content     Content     `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+// NameValue is a header, a cookie or a query param.
+type NameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// PostData is the body of a request.
+type PostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// Content is the body of a response.
+type Content struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// Timings are how long the phases of a request took, in milliseconds.
+type Timings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// Read reads the HAR file at the given path.
+func Read(path string) (*HAR, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HAR file: %w", err)
+	}
+
+	var archive HAR
+	if err := json.Unmarshal(content, &archive); err != nil {
+		return nil, fmt.Errorf("failed to parse HAR file %s: %w", path, err)
+	}
+
+	return &archive, nil
+}
+
+// Write writes the HAR file to the given path. Only the
+// current user can read it, as it holds tenant data.
+func Write(path string, archive *HAR) error {
+	content, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		return fmt.Errorf("failed to write HAR file: %w", err)
+	}
+
+	return nil
+}
+
+func headersFrom(header http.Header) []NameValue {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	headers := make([]NameValue, 0, len(header))
+	for _, name := range names {
+		for _, value := range header[name] {
+			if redactedHeaders[http.CanonicalHeaderKey(name)] {
+				value = Redacted
+			}
+			headers = append(headers, NameValue{Name: name, Value: value})
+		}
+	}
+
+	return headers
+}
+
+func headersTo(headers []NameValue) http.Header {
+	header := make(http.Header, len(headers))
+	for _, h := range headers {
+		header.Add(h.Name, h.Value)
+	}
+
+	return header
+}
+
+// redactBody redacts the secrets of a JSON body. Other bodies,
+// and JSON bodies without secrets, are returned as is.
+func redactBody(body []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || !redactValue(value) {
+		return body
+	}
+
+	var redacted bytes.Buffer
+	encoder := json.NewEncoder(&redacted)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return body
+	}
+
+	return bytes.TrimSuffix(redacted.Bytes(), []byte("\n"))
+}
+
+// redactValue redacts the secret fields of a decoded JSON value in place, and reports whether
+// it redacted any. Only strings are redacted, as objects such as the refresh_token settings
+// of a client hold no secret. Lists of secrets, such as the secrets of an action, hold
+// name and value pairs, whose values are redacted.
+func redactValue(value interface{}) bool {
+	redacted := false
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if text, ok := field.(string); ok && text != "" && isSecretField(key) {
+				v[key] = Redacted
+				redacted = true
+				continue
+			}
+			if items, ok := field.([]interface{}); ok && isSecretField(key) && redactSecretValues(items) {
+				redacted = true
+				continue
+			}
+			if redactValue(field) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if redactValue(item) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+// redactSecretValues redacts the values of a list of name and value pairs in place,
+// and reports whether it redacted any.
+func redactSecretValues(items []interface{}) bool {
+	redacted := false
+
+	for _, item := range items {
+		secret, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if text, ok := secret["value"].(string); ok && text != "" {
+			secret["value"] = Redacted
+			redacted = true
+		}
+	}
+
+	return redacted
+}
+
+func isSecretField(name string) bool {
+	name = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	if strings.Contains(name, "secret") {
+		return true
+	}
+
+	for _, suffix := range redactedFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,151 @@
+package har
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": "App", "client_secret": "s3cr3t"}`, string(body))
+
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(http.StatusCreated)
+		_, _ = writer.Write([]byte(`{"client_id":"1","client_secret":"s3cr3t","refresh_token":{"rotation_type":"rotating"},"callbacks":["https://example.com/?a=1&b=2"]}`))
+	}))
+	t.Cleanup(testServer.Close)
+
+	path := filepath.Join(t.TempDir(), "recording.har")
+	recorder := NewRecorder(path, http.DefaultTransport)
+	client := &http.Client{Transport: recorder}
+
+	request, err := http.NewRequest(http.MethodPost, testServer.URL+"/api/v2/clients?fields=name", strings.NewReader(`{"name": "App", "client_secret": "s3cr3t"}`))
+	require.NoError(t, err)
+	request.Header.Set("Authorization", "Bearer token")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = response.Body.Close()
+	})
+
+	// The response is still readable after being recorded.
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"client_secret":"s3cr3t"`)
+
+	// The recording is only written once the recorder is closed.
+	assert.NoFileExists(t, path)
+	require.NoError(t, recorder.Close())
+
+	archive, err := Read(path)
+	require.NoError(t, err)
+	require.Len(t, archive.Log.Entries, 1)
+
+	entry := archive.Log.Entries[0]
+	assert.Equal(t, http.MethodPost, entry.Request.Method)
+	assert.Equal(t, testServer.URL+"/api/v2/clients?fields=name", entry.Request.URL)
+	assert.Contains(t, entry.Request.Headers, NameValue{Name: "Authorization", Value: Redacted})
+	assert.Equal(t, []NameValue{{Name: "fields", Value: "name"}}, entry.Request.QueryString)
+	assert.JSONEq(t, `{"name": "App", "client_secret": "REDACTED"}`, entry.Request.PostData.Text)
+
+	assert.Equal(t, http.StatusCreated, entry.Response.Status)
+	assert.Equal(t, "application/json", entry.Response.Content.MimeType)
+	assert.Equal(t, `{"callbacks":["https://example.com/?a=1&b=2"],"client_id":"1","client_secret":"REDACTED","refresh_token":{"rotation_type":"rotating"}}`, entry.Response.Content.Text)
+}
+
+func TestReplayer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.har")
+	require.NoError(t, Write(path, &HAR{Log: Log{Version: version, Entries: []Entry{
+		replayEntry(http.MethodGet, "https://example.auth0.com/api/v2/users?page=0&per_page=1", `[{"user_id":"auth0|1"}]`),
+		replayEntry(http.MethodGet, "https://other.auth0.com/api/v2/users?page=0&per_page=1", `[{"user_id":"auth0|3"}]`),
+		replayEntry(http.MethodGet, "https://example.auth0.com/api/v2/users?page=0&per_page=1", `[{"user_id":"auth0|2"}]`),
+	}}}))
+
+	replayer, err := NewReplayer(path)
+	require.NoError(t, err)
+	assert.Equal(t, "example.auth0.com", replayer.Host())
+
+	client := &http.Client{Transport: replayer}
+
+	t.Run("it replays the responses in the order they were recorded", func(t *testing.T) {
+		for _, expected := range []string{`[{"user_id":"auth0|1"}]`, `[{"user_id":"auth0|2"}]`} {
+			// The order of the query params doesn't matter.
+			response, err := client.Get("https://example.auth0.com/api/v2/users?per_page=1&page=0")
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(response.Body)
+			require.NoError(t, err)
+			require.NoError(t, response.Body.Close())
+
+			assert.Equal(t, http.StatusOK, response.StatusCode)
+			assert.Equal(t, "application/json", response.Header.Get("Content-Type"))
+			assert.Equal(t, expected, string(body))
+		}
+	})
+
+	t.Run("it replays the responses recorded for the host of the request", func(t *testing.T) {
+		response, err := client.Get("https://other.auth0.com/api/v2/users?per_page=1&page=0")
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+
+		assert.Equal(t, `[{"user_id":"auth0|3"}]`, string(body))
+	})
+
+	t.Run("it fails for a request that was not recorded", func(t *testing.T) {
+		_, err := client.Get("https://example.auth0.com/api/v2/users?per_page=1&page=0")
+		assert.ErrorIs(t, err, ErrNotRecorded)
+		assert.ErrorContains(t, err, "no recorded response for GET example.auth0.com/api/v2/users?page=0&per_page=1")
+	})
+}
+
+func TestRedactBody(t *testing.T) {
+	t.Run("it redacts the values of the secrets of an action", func(t *testing.T) {
+		body := redactBody([]byte(`{"name":"Log in","secrets":[{"name":"API_KEY","value":"s3cr3t"},{"name":"EMPTY"}]}`))
+		assert.JSONEq(t, `{"name":"Log in","secrets":[{"name":"API_KEY","value":"REDACTED"},{"name":"EMPTY"}]}`, string(body))
+	})
+
+	t.Run("it redacts the ticket of a password change", func(t *testing.T) {
+		body := redactBody([]byte(`{"ticket":"https://example.auth0.com/lo/reset?ticket=abc#"}`))
+		assert.JSONEq(t, `{"ticket":"REDACTED"}`, string(body))
+	})
+
+	t.Run("it leaves bodies without secrets as they are", func(t *testing.T) {
+		body := []byte(`{"name": "App", "callbacks": []}`)
+		assert.Equal(t, body, redactBody(body))
+	})
+}
+
+func TestIsSecretField(t *testing.T) {
+	for _, name := range []string{"client_secret", "password", "api_key", "splunkToken", "datadogApiKey", "secretAccessKey", "smtp_pass", "ticket"} {
+		assert.True(t, isSecretField(name), name)
+	}
+
+	for _, name := range []string{"name", "password_policy", "passwordPolicy", "token_endpoint_auth_method", "token_lifetime"} {
+		assert.False(t, isSecretField(name), name)
+	}
+}
+
+func replayEntry(method, url, body string) Entry {
+	return Entry{
+		Request: Request{Method: method, URL: url},
+		Response: Response{
+			Status:  http.StatusOK,
+			Headers: []NameValue{{Name: "Content-Type", Value: "application/json"}, {Name: "Content-Length", Value: "1"}},
+			Content: Content{Text: body},
+		},
+	}
+}
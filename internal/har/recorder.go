@@ -0,0 +1,158 @@
+package har
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/auth0/auth0-cli/internal/buildinfo"
+)
+
+// Recorder is an http.RoundTripper that records every request it sends, and
+// the response it got, to a HAR file. Credentials and secrets are redacted.
+//
+// The entries are kept in memory and the file is written once, on Close,
+// so that recording many requests doesn't rewrite the file after each one.
+type Recorder struct {
+	path string
+	base http.RoundTripper
+
+	mu      sync.Mutex
+	archive HAR
+}
+
+// NewRecorder returns a Recorder sending the requests through the base transport
+// and recording them to the file at the given path, which it overwrites.
+func NewRecorder(path string, base http.RoundTripper) *Recorder {
+	return &Recorder{
+		path: path,
+		base: base,
+		archive: HAR{
+			Log: Log{
+				Version: version,
+				Creator: Creator{Name: "auth0-cli", Version: buildinfo.Version},
+				Entries: []Entry{},
+			},
+		},
+	}
+}
+
+// RoundTrip sends the request and records it alongside its response.
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		body, err := io.ReadAll(request.Body)
+		_ = request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		requestBody = body
+		request = request.Clone(request.Context())
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	startedAt := time.Now()
+
+	response, err := r.base.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	elapsed := float64(time.Since(startedAt).Microseconds()) / 1000
+
+	entry := Entry{
+		StartedDateTime: startedAt.Format(time.RFC3339Nano),
+		Time:            elapsed,
+		Request:         recordRequest(request, requestBody),
+		Response:        recordResponse(response, responseBody),
+		Timings:         Timings{Wait: elapsed},
+	}
+
+	r.record(entry)
+
+	return response, nil
+}
+
+// Close writes the recorded entries to the HAR file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return Write(r.path, &r.archive)
+}
+
+func (r *Recorder) record(entry Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.archive.Log.Entries = append(r.archive.Log.Entries, entry)
+}
+
+func recordRequest(request *http.Request, body []byte) Request {
+	params := request.URL.Query()
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	query := make([]NameValue, 0, len(params))
+	for _, name := range names {
+		for _, value := range params[name] {
+			query = append(query, NameValue{Name: name, Value: value})
+		}
+	}
+
+	recorded := Request{
+		Method:      request.Method,
+		URL:         request.URL.String(),
+		HTTPVersion: "HTTP/1.1",
+		Cookies:     []NameValue{},
+		Headers:     headersFrom(request.Header),
+		QueryString: query,
+		HeadersSize: -1,
+		BodySize:    len(body),
+	}
+
+	if len(body) > 0 {
+		recorded.PostData = &PostData{
+			MimeType: request.Header.Get("Content-Type"),
+			Text:     string(redactBody(body)),
+		}
+	}
+
+	return recorded
+}
+
+func recordResponse(response *http.Response, body []byte) Response {
+	httpVersion := response.Proto
+	if httpVersion == "" {
+		httpVersion = "HTTP/1.1"
+	}
+
+	return Response{
+		Status:      response.StatusCode,
+		StatusText:  http.StatusText(response.StatusCode),
+		HTTPVersion: httpVersion,
+		Cookies:     []NameValue{},
+		Headers:     headersFrom(response.Header),
+		Content: Content{
+			Size:     len(body),
+			MimeType: response.Header.Get("Content-Type"),
+			Text:     string(redactBody(body)),
+		},
+		HeadersSize: -1,
+		BodySize:    len(body),
+	}
+}
@@ -0,0 +1,119 @@
+package har
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrNotRecorded is returned for a request that has no response left in the recording.
+var ErrNotRecorded = errors.New("no recorded response")
+
+// Replayer is an http.RoundTripper that serves the responses of a
+// recording instead of sending the requests.
+//
+// A request gets the response of the first entry not yet replayed with the same
+// method, host, path and query params, so that requests sent several times, such as
+// the pages of a list, get their responses in the order they were recorded, and
+// requests sent to several tenants get the responses of their own tenant.
+type Replayer struct {
+	entries []Entry
+
+	mu       sync.Mutex
+	replayed []bool
+}
+
+// NewReplayer returns a Replayer serving the responses of the HAR file at the given path.
+func NewReplayer(path string) (*Replayer, error) {
+	archive, err := Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Replayer{
+		entries:  archive.Log.Entries,
+		replayed: make([]bool, len(archive.Log.Entries)),
+	}, nil
+}
+
+// Host returns the host of the first recorded request, which is the tenant
+// domain for a recording of the Management API.
+func (r *Replayer) Host() string {
+	for _, entry := range r.entries {
+		if entryURL, err := url.Parse(entry.Request.URL); err == nil && entryURL.Host != "" {
+			return entryURL.Host
+		}
+	}
+
+	return ""
+}
+
+// RoundTrip serves the recorded response of the request.
+func (r *Replayer) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Body != nil {
+		_ = request.Body.Close()
+	}
+
+	key := requestKey(request.Method, request.URL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, entry := range r.entries {
+		if r.replayed[i] {
+			continue
+		}
+
+		entryURL, err := url.Parse(entry.Request.URL)
+		if err != nil || requestKey(entry.Request.Method, entryURL) != key {
+			continue
+		}
+
+		r.replayed[i] = true
+
+		return replayResponse(request, entry.Response), nil
+	}
+
+	return nil, fmt.Errorf("%w for %s", ErrNotRecorded, key)
+}
+
+func requestKey(method string, requestURL *url.URL) string {
+	key := strings.ToUpper(method) + " " + requestURL.Host + requestURL.Path
+	if query := requestURL.Query().Encode(); query != "" {
+		key += "?" + query
+	}
+
+	return key
+}
+
+func replayResponse(request *http.Request, recorded Response) *http.Response {
+	header := headersTo(recorded.Headers)
+	// The recorded body might have been redacted, and so be of another length.
+	header.Del("Content-Length")
+
+	proto := recorded.HTTPVersion
+	if proto == "" {
+		proto = "HTTP/1.1"
+	}
+	major, minor, ok := http.ParseHTTPVersion(proto)
+	if !ok {
+		major, minor = 1, 1
+	}
+
+	return &http.Response{
+		Status:        strconv.Itoa(recorded.Status) + " " + http.StatusText(recorded.Status),
+		StatusCode:    recorded.Status,
+		Proto:         proto,
+		ProtoMajor:    major,
+		ProtoMinor:    minor,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Content.Text)),
+		ContentLength: int64(len(recorded.Content.Text)),
+		Request:       request,
+	}
+}
@@ -0,0 +1,105 @@
+package keyring
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
+)
+
+// commandStore keeps the secrets through an external credential helper,
+// much like git credential helpers. The helper is run with one of the
+// get, store or erase actions as its last argument, and reads the
+// request from its stdin as key=value lines:
+//
+//	service=Auth0 CLI Client Secret
+//	tenant=example.us.auth0.com
+//	secret=... (store only)
+//
+// On get, the helper prints the secret=... line, or nothing at all if the
+// secret doesn't exist. A helper exiting with a non-zero code fails the action,
+// unless it prints "not found" to its stderr, which means the secret doesn't
+// exist. Erasing a secret that doesn't exist is reported as such, as not every
+// helper fails to erase those.
+type commandStore struct {
+	args []string
+}
+
+func newCommandStore(command string) (*commandStore, error) {
+	args, err := shellquote.Split(command)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the command of the credential store: %w", err)
+	}
+
+	if len(args) == 0 {
+		return nil, errors.New("the command credential store requires a command")
+	}
+
+	return &commandStore{args: args}, nil
+}
+
+func (s *commandStore) Get(service, tenant string) (string, error) {
+	output, err := s.run("get", service, tenant, "")
+	if err != nil {
+		return "", err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		if value, found := strings.CutPrefix(scanner.Text(), "secret="); found {
+			return value, nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read the output of the credential helper: %w", err)
+	}
+
+	return "", ErrNotFound
+}
+
+func (s *commandStore) Set(service, tenant, value string) error {
+	_, err := s.run("store", service, tenant, value)
+	return err
+}
+
+func (s *commandStore) Delete(service, tenant string) error {
+	if _, err := s.Get(service, tenant); err != nil {
+		return err
+	}
+
+	_, err := s.run("erase", service, tenant, "")
+	return err
+}
+
+func (s *commandStore) run(action, service, tenant, value string) ([]byte, error) {
+	var input strings.Builder
+	fmt.Fprintf(&input, "service=%s\ntenant=%s\n", service, tenant)
+	if action == "store" {
+		fmt.Fprintf(&input, "secret=%s\n", value)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(s.args[0], append(s.args[1:], action)...) // nolint:gosec
+	cmd.Stdin = strings.NewReader(input.String())
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if strings.Contains(strings.ToLower(message), "not found") {
+			return nil, ErrNotFound
+		}
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, fmt.Errorf("credential helper %q failed to %s the secret: %s", s.args[0], action, message)
+	}
+
+	return stdout.Bytes(), nil
+}
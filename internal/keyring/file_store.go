@@ -0,0 +1,215 @@
+package keyring
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"filippo.io/age"
+)
+
+// PassphraseEnvVar holds the passphrase that the file backend encrypts the secrets with.
+const PassphraseEnvVar = "AUTH0_CREDENTIALS_PASSPHRASE"
+
+// fileStoreWorkFactor is the scrypt work factor of the passphrase, the one
+// recommended for interactive logins, as each write of the file derives the key.
+const fileStoreWorkFactor = 15
+
+// fileStore keeps the secrets as JSON in an age file encrypted with a passphrase,
+// so that the file can also be decrypted with the age CLI.
+type fileStore struct {
+	mu         sync.Mutex
+	path       string
+	passphrase string
+
+	// The secrets are decrypted once per change of the file, as scrypt is slow by design.
+	secrets map[string]string
+	modTime time.Time
+	size    int64
+}
+
+func newFileStore(path string) (*fileStore, error) {
+	if path == "" {
+		return nil, errors.New("the file credential store requires a path")
+	}
+
+	passphrase := os.Getenv(PassphraseEnvVar)
+	if passphrase == "" {
+		return nil, fmt.Errorf("the file credential store requires a passphrase in the %s environment variable", PassphraseEnvVar)
+	}
+
+	return &fileStore{path: path, passphrase: passphrase}, nil
+}
+
+func (s *fileStore) Get(service, tenant string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secrets, err := s.read()
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := secrets[fileStoreKey(service, tenant)]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return value, nil
+}
+
+func (s *fileStore) Set(service, tenant, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secrets, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	secrets[fileStoreKey(service, tenant)] = value
+
+	return s.write(secrets)
+}
+
+func (s *fileStore) Delete(service, tenant string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secrets, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	key := fileStoreKey(service, tenant)
+	if _, ok := secrets[key]; !ok {
+		return ErrNotFound
+	}
+
+	delete(secrets, key)
+
+	return s.write(secrets)
+}
+
+// read returns a copy of the secrets, decrypting the file only if it changed since last read.
+func (s *fileStore) read() (map[string]string, error) {
+	info, err := os.Stat(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credential store: %w", err)
+	}
+
+	if s.secrets == nil || !info.ModTime().Equal(s.modTime) || info.Size() != s.size {
+		secrets, err := s.decrypt()
+		if err != nil {
+			return nil, err
+		}
+
+		s.secrets, s.modTime, s.size = secrets, info.ModTime(), info.Size()
+	}
+
+	secrets := make(map[string]string, len(s.secrets))
+	for key, value := range s.secrets {
+		secrets[key] = value
+	}
+
+	return secrets, nil
+}
+
+func (s *fileStore) decrypt() (map[string]string, error) {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credential store: %w", err)
+	}
+	defer file.Close()
+
+	identity, err := age.NewScryptIdentity(s.passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := age.Decrypt(file, identity)
+	if err != nil {
+		var noMatch *age.NoIdentityMatchError
+		if errors.As(err, &noMatch) {
+			return nil, fmt.Errorf("failed to decrypt credential store, check the passphrase in %s", PassphraseEnvVar)
+		}
+		return nil, fmt.Errorf("failed to decrypt credential store: %w", err)
+	}
+
+	plaintext, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt credential store: %w", err)
+	}
+
+	secrets := map[string]string{}
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, fmt.Errorf("failed to parse credential store: %w", err)
+	}
+
+	return secrets, nil
+}
+
+func (s *fileStore) write(secrets map[string]string) error {
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	recipient, err := age.NewScryptRecipient(s.passphrase)
+	if err != nil {
+		return err
+	}
+	recipient.SetWorkFactor(fileStoreWorkFactor)
+
+	var buffer bytes.Buffer
+	writer, err := age.Encrypt(&buffer, recipient)
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(plaintext); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	const dirPerm os.FileMode = 0700 // Directory permissions (read, write, and execute for the owner only).
+	if err := os.MkdirAll(filepath.Dir(s.path), dirPerm); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so that a failed write doesn't lose the secrets.
+	const filePerm os.FileMode = 0600 // File permissions (read and write for the owner only).
+	temporaryPath := s.path + ".tmp"
+	if err := os.WriteFile(temporaryPath, buffer.Bytes(), filePerm); err != nil {
+		return fmt.Errorf("failed to write credential store: %w", err)
+	}
+
+	if err := os.Rename(temporaryPath, s.path); err != nil {
+		return err
+	}
+
+	// Keep the written secrets, so that the next read doesn't decrypt them again.
+	info, err := os.Stat(s.path)
+	if err != nil {
+		s.secrets = nil
+		return nil
+	}
+	s.secrets, s.modTime, s.size = secrets, info.ModTime(), info.Size()
+
+	return nil
+}
+
+func fileStoreKey(service, tenant string) string {
+	return strings.Join([]string{service, tenant}, "|")
+}
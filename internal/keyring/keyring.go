@@ -4,8 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-
-	"github.com/zalando/go-keyring"
 )
 
 const (
@@ -19,14 +17,14 @@ const (
 	secretAccessTokenMaxChunks = 50
 )
 
-// StoreClientSecret stores a tenant's client secret in the system keyring.
+// StoreClientSecret stores a tenant's client secret in the credential store.
 func StoreClientSecret(tenant, value string) error {
-	return keyring.Set(secretClientSecret, tenant, value)
+	return store.Set(secretClientSecret, tenant, value)
 }
 
-// GetClientSecret retrieves a tenant's client secret from the system keyring.
+// GetClientSecret retrieves a tenant's client secret from the credential store.
 func GetClientSecret(tenant string) (string, error) {
-	return keyring.Get(secretClientSecret, tenant)
+	return store.Get(secretClientSecret, tenant)
 }
 
 // DeleteSecretsForTenant deletes all secrets for a given tenant.
@@ -34,24 +32,20 @@ func DeleteSecretsForTenant(tenant string) error {
 	var multiErrors []string
 
 	// Refresh tokens aren't supported anymore; this remains to clear existing ones.
-	if err := keyring.Delete(secretRefreshToken, tenant); err != nil {
-		if !errors.Is(err, keyring.ErrNotFound) {
-			multiErrors = append(multiErrors, fmt.Sprintf("failed to delete refresh token from keyring: %s", err))
+	if err := store.Delete(secretRefreshToken, tenant); err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			multiErrors = append(multiErrors, fmt.Sprintf("failed to delete refresh token from credential store: %s", err))
 		}
 	}
 
-	if err := keyring.Delete(secretClientSecret, tenant); err != nil {
-		if !errors.Is(err, keyring.ErrNotFound) {
-			multiErrors = append(multiErrors, fmt.Sprintf("failed to delete client secret from keyring: %s", err))
+	if err := store.Delete(secretClientSecret, tenant); err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			multiErrors = append(multiErrors, fmt.Sprintf("failed to delete client secret from credential store: %s", err))
 		}
 	}
 
-	for i := 0; i < secretAccessTokenMaxChunks; i++ {
-		if err := keyring.Delete(fmt.Sprintf("%s %d", secretAccessToken, i), tenant); err != nil {
-			if !errors.Is(err, keyring.ErrNotFound) {
-				multiErrors = append(multiErrors, fmt.Sprintf("failed to delete access token from keyring: %s", err))
-			}
-		}
+	if err := deleteAccessToken(tenant); err != nil {
+		multiErrors = append(multiErrors, fmt.Sprintf("failed to delete access token from credential store: %s", err))
 	}
 
 	if len(multiErrors) == 0 {
@@ -63,19 +57,31 @@ func DeleteSecretsForTenant(tenant string) error {
 
 func StoreAccessToken(tenant, value string) error {
 	// First, clear any existing chunks to prevent concatenation issues.
-	for i := 0; i < secretAccessTokenMaxChunks; i++ {
-		if err := keyring.Delete(fmt.Sprintf("%s %d", secretAccessToken, i), tenant); err != nil {
-			if !errors.Is(err, keyring.ErrNotFound) {
-				return fmt.Errorf("failed to delete access token chunk from keyring: %s", err)
-			}
-		}
+	if err := deleteAccessToken(tenant); err != nil {
+		return fmt.Errorf("failed to delete access token chunk from credential store: %s", err)
 	}
 
 	// Now store the new token in chunks.
 	chunks := chunk(value, secretAccessTokenChunkSizeInBytes)
 
 	for i := 0; i < len(chunks); i++ {
-		err := keyring.Set(fmt.Sprintf("%s %d", secretAccessToken, i), tenant, chunks[i])
+		err := store.Set(fmt.Sprintf("%s %d", secretAccessToken, i), tenant, chunks[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteAccessToken deletes the chunks of the access token. They're stored
+// from the first one onwards, so the first missing chunk is the last one.
+func deleteAccessToken(tenant string) error {
+	for i := 0; i < secretAccessTokenMaxChunks; i++ {
+		err := store.Delete(fmt.Sprintf("%s %d", secretAccessToken, i), tenant)
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
 		if err != nil {
 			return err
 		}
@@ -88,10 +94,10 @@ func GetAccessToken(tenant string) (string, error) {
 	var accessToken string
 
 	for i := 0; i < secretAccessTokenMaxChunks; i++ {
-		a, err := keyring.Get(fmt.Sprintf("%s %d", secretAccessToken, i), tenant)
+		a, err := store.Get(fmt.Sprintf("%s %d", secretAccessToken, i), tenant)
 		// Only return if we have pulled more than 1 item from the keyring, otherwise this will be
 		// a valid "secret not found in keyring".
-		if err == keyring.ErrNotFound && i > 0 {
+		if err == ErrNotFound && i > 0 {
 			return accessToken, nil
 		}
 		if err != nil {
@@ -0,0 +1,135 @@
+package keyring
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zalando/go-keyring"
+)
+
+// The backends that the secrets can be kept in.
+const (
+	BackendKeyring = "keyring"
+	BackendFile    = "file"
+	BackendCommand = "command"
+)
+
+// ErrNotFound is returned by the stores when a secret doesn't exist.
+var ErrNotFound = keyring.ErrNotFound
+
+// Store keeps the secrets of the CLI, identified by a service and a tenant.
+type Store interface {
+	Get(service, tenant string) (string, error)
+	Set(service, tenant, value string) error
+	Delete(service, tenant string) error
+}
+
+// Options selects the backend of the store and configures it.
+type Options struct {
+	// Backend is one of keyring, file or command. Defaults to keyring.
+	Backend string `json:"backend,omitempty"`
+
+	// Path is the encrypted file that the file backend keeps the secrets in.
+	Path string `json:"path,omitempty"`
+
+	// Command is the credential helper that the command backend runs.
+	Command string `json:"command,omitempty"`
+}
+
+// store is where the secrets are kept, see UseStore.
+var store Store = systemStore{}
+
+// NewStore creates the store for the backend of the options. Secrets
+// previously kept in the OS keyring move to the new store the first
+// time they're read, so that switching backends doesn't log us out.
+func NewStore(options Options) (Store, error) {
+	switch options.Backend {
+	case "", BackendKeyring:
+		return systemStore{}, nil
+	case BackendFile:
+		fileStore, err := newFileStore(options.Path)
+		if err != nil {
+			return nil, err
+		}
+		return &migratingStore{primary: fileStore, legacy: systemStore{}}, nil
+	case BackendCommand:
+		commandStore, err := newCommandStore(options.Command)
+		if err != nil {
+			return nil, err
+		}
+		return &migratingStore{primary: commandStore, legacy: systemStore{}}, nil
+	default:
+		return nil, fmt.Errorf(
+			"unknown credential store backend %q, please use one of: %s, %s, %s",
+			options.Backend,
+			BackendKeyring,
+			BackendFile,
+			BackendCommand,
+		)
+	}
+}
+
+// UseStore makes the secrets be kept in the given store from now on.
+func UseStore(s Store) {
+	store = s
+}
+
+// systemStore keeps the secrets in the OS keyring.
+type systemStore struct{}
+
+func (systemStore) Get(service, tenant string) (string, error) {
+	return keyring.Get(service, tenant)
+}
+
+func (systemStore) Set(service, tenant, value string) error {
+	return keyring.Set(service, tenant, value)
+}
+
+func (systemStore) Delete(service, tenant string) error {
+	return keyring.Delete(service, tenant)
+}
+
+// migratingStore moves the secrets from the legacy store to the primary
+// one when they're read. The legacy store may not be usable at all, e.g.
+// the OS keyring on a headless Linux machine, so its errors are ignored.
+type migratingStore struct {
+	primary Store
+	legacy  Store
+}
+
+func (s *migratingStore) Get(service, tenant string) (string, error) {
+	value, err := s.primary.Get(service, tenant)
+	if !errors.Is(err, ErrNotFound) {
+		return value, err
+	}
+
+	legacyValue, legacyErr := s.legacy.Get(service, tenant)
+	if legacyErr != nil {
+		return "", err
+	}
+
+	if err := s.primary.Set(service, tenant, legacyValue); err != nil {
+		return "", fmt.Errorf("failed to move secret to the credential store: %w", err)
+	}
+
+	_ = s.legacy.Delete(service, tenant)
+
+	return legacyValue, nil
+}
+
+func (s *migratingStore) Set(service, tenant, value string) error {
+	return s.primary.Set(service, tenant, value)
+}
+
+// Delete deletes the secret from both stores. It's only
+// missing if it was found in neither of them.
+func (s *migratingStore) Delete(service, tenant string) error {
+	legacyErr := s.legacy.Delete(service, tenant)
+
+	err := s.primary.Delete(service, tenant)
+	if errors.Is(err, ErrNotFound) && legacyErr == nil {
+		return nil
+	}
+
+	return err
+}
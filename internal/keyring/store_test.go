@@ -0,0 +1,232 @@
+package keyring
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+// testCredentialHelper keeps each secret in a file of the directory passed as its first argument.
+const testCredentialHelper = `#!/bin/sh
+while IFS='=' read -r key value; do
+	case "$key" in
+		service) service="$value" ;;
+		tenant) tenant="$value" ;;
+		secret) secret="$value" ;;
+	esac
+done
+file="$1/$(echo "$service $tenant" | tr ' /' '__')"
+case "$2" in
+	get) [ -f "$file" ] && printf 'secret=%s\n' "$(cat "$file")" ;;
+	store) printf '%s' "$secret" > "$file" ;;
+	erase) rm -f "$file" ;;
+esac
+exit 0
+`
+
+func TestNewStore(t *testing.T) {
+	t.Run("it defaults to the OS keyring", func(t *testing.T) {
+		store, err := NewStore(Options{})
+		require.NoError(t, err)
+		assert.Equal(t, systemStore{}, store)
+	})
+
+	t.Run("it fails with an unknown backend", func(t *testing.T) {
+		_, err := NewStore(Options{Backend: "vault"})
+		assert.EqualError(t, err, `unknown credential store backend "vault", please use one of: keyring, file, command`)
+	})
+
+	t.Run("it fails to use the file backend without a passphrase", func(t *testing.T) {
+		t.Setenv(PassphraseEnvVar, "")
+
+		_, err := NewStore(Options{Backend: BackendFile, Path: filepath.Join(t.TempDir(), "credentials")})
+		assert.EqualError(t, err, "the file credential store requires a passphrase in the AUTH0_CREDENTIALS_PASSPHRASE environment variable")
+	})
+
+	t.Run("it fails to use the command backend without a command", func(t *testing.T) {
+		_, err := NewStore(Options{Backend: BackendCommand})
+		assert.EqualError(t, err, "the command credential store requires a command")
+	})
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	t.Setenv(PassphraseEnvVar, "correct horse battery staple")
+
+	store, err := newFileStore(path)
+	require.NoError(t, err)
+
+	_, err = store.Get(secretClientSecret, testTenantName)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Set(secretClientSecret, testTenantName, "some-client-secret"))
+
+	// The secrets aren't readable from the file, but can be decrypted with age.
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "some-client-secret")
+
+	identity, err := age.NewScryptIdentity("correct horse battery staple")
+	require.NoError(t, err)
+	plaintext, err := age.Decrypt(bytes.NewReader(content), identity)
+	require.NoError(t, err)
+	secrets, err := io.ReadAll(plaintext)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Auth0 CLI Client Secret|auth0-cli-test.us.auth0.com": "some-client-secret"}`, string(secrets))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	t.Run("it retrieves the secrets with the same passphrase", func(t *testing.T) {
+		otherStore, err := newFileStore(path)
+		require.NoError(t, err)
+
+		value, err := otherStore.Get(secretClientSecret, testTenantName)
+		require.NoError(t, err)
+		assert.Equal(t, "some-client-secret", value)
+	})
+
+	t.Run("it fails to retrieve the secrets with another passphrase", func(t *testing.T) {
+		t.Setenv(PassphraseEnvVar, "wrong")
+
+		otherStore, err := newFileStore(path)
+		require.NoError(t, err)
+
+		_, err = otherStore.Get(secretClientSecret, testTenantName)
+		assert.EqualError(t, err, "failed to decrypt credential store, check the passphrase in AUTH0_CREDENTIALS_PASSPHRASE")
+	})
+
+	t.Run("it deletes the secrets", func(t *testing.T) {
+		require.NoError(t, store.Delete(secretClientSecret, testTenantName))
+
+		_, err := store.Get(secretClientSecret, testTenantName)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorIs(t, store.Delete(secretClientSecret, testTenantName), ErrNotFound)
+	})
+}
+
+func TestCommandStore(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test credential helper is a shell script")
+	}
+
+	dir := t.TempDir()
+	helper := filepath.Join(dir, "credential-helper")
+	require.NoError(t, os.WriteFile(helper, []byte(testCredentialHelper), 0700))
+
+	secretsDir := filepath.Join(dir, "secrets dir")
+	require.NoError(t, os.Mkdir(secretsDir, 0700))
+
+	store, err := newCommandStore(helper + ` "` + secretsDir + `"`)
+	require.NoError(t, err)
+
+	_, err = store.Get(secretClientSecret, testTenantName)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Set(secretClientSecret, testTenantName, "some-client-secret"))
+
+	value, err := store.Get(secretClientSecret, testTenantName)
+	require.NoError(t, err)
+	assert.Equal(t, "some-client-secret", value)
+
+	require.NoError(t, store.Delete(secretClientSecret, testTenantName))
+
+	_, err = store.Get(secretClientSecret, testTenantName)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	t.Run("it fails when the helper fails", func(t *testing.T) {
+		failingHelper := filepath.Join(dir, "failing-helper")
+		require.NoError(t, os.WriteFile(failingHelper, []byte("#!/bin/sh\necho 'vault is locked' >&2\nexit 1\n"), 0700))
+
+		store, err := newCommandStore(failingHelper)
+		require.NoError(t, err)
+
+		_, err = store.Get(secretClientSecret, testTenantName)
+		assert.EqualError(t, err, `credential helper "`+failingHelper+`" failed to get the secret: vault is locked`)
+	})
+
+	t.Run("it reports the secrets that the helper fails to find as missing", func(t *testing.T) {
+		notFoundHelper := filepath.Join(dir, "not-found-helper")
+		require.NoError(t, os.WriteFile(notFoundHelper, []byte("#!/bin/sh\necho 'secret not found' >&2\nexit 1\n"), 0700))
+
+		store, err := newCommandStore(notFoundHelper)
+		require.NoError(t, err)
+
+		_, err = store.Get(secretClientSecret, testTenantName)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorIs(t, store.Delete(secretClientSecret, testTenantName), ErrNotFound)
+	})
+
+	t.Run("it only runs the helper for the chunks of the access token", func(t *testing.T) {
+		t.Cleanup(func() {
+			UseStore(systemStore{})
+		})
+
+		calls := filepath.Join(dir, "calls")
+		countingHelper := filepath.Join(dir, "counting-helper")
+		require.NoError(t, os.WriteFile(countingHelper, []byte("#!/bin/sh\necho \"$2\" >> \""+calls+"\"\nexec \""+helper+"\" \"$@\"\n"), 0700))
+
+		store, err := newCommandStore(countingHelper + ` "` + secretsDir + `"`)
+		require.NoError(t, err)
+		UseStore(store)
+
+		// Looking for the first chunk to delete, then storing the token.
+		require.NoError(t, StoreAccessToken(testTenantName, "some-access-token"))
+		content, err := os.ReadFile(calls)
+		require.NoError(t, err)
+		assert.Equal(t, "get\nstore\n", string(content))
+
+		require.NoError(t, DeleteSecretsForTenant(testTenantName))
+		content, err = os.ReadFile(calls)
+		require.NoError(t, err)
+		assert.Equal(t, "get\nstore\n"+"get\nget\nget\nerase\nget\n", string(content))
+	})
+}
+
+func TestMigratingStore(t *testing.T) {
+	keyring.MockInit()
+	t.Setenv(PassphraseEnvVar, "correct horse battery staple")
+	t.Cleanup(func() {
+		UseStore(systemStore{})
+	})
+
+	// Secrets stored before switching backends.
+	require.NoError(t, StoreClientSecret(testTenantName, "some-client-secret"))
+	require.NoError(t, StoreAccessToken(testTenantName, "some-access-token"))
+
+	store, err := NewStore(Options{Backend: BackendFile, Path: filepath.Join(t.TempDir(), "credentials")})
+	require.NoError(t, err)
+	UseStore(store)
+
+	clientSecret, err := GetClientSecret(testTenantName)
+	require.NoError(t, err)
+	assert.Equal(t, "some-client-secret", clientSecret)
+
+	accessToken, err := GetAccessToken(testTenantName)
+	require.NoError(t, err)
+	assert.Equal(t, "some-access-token", accessToken)
+
+	// The secrets moved out of the OS keyring.
+	_, err = keyring.Get(secretClientSecret, testTenantName)
+	assert.ErrorIs(t, err, keyring.ErrNotFound)
+
+	clientSecret, err = GetClientSecret(testTenantName)
+	require.NoError(t, err)
+	assert.Equal(t, "some-client-secret", clientSecret)
+
+	require.NoError(t, DeleteSecretsForTenant(testTenantName))
+
+	_, err = GetClientSecret(testTenantName)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,264 @@
+package users
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MaxImportSize is the maximum size, in bytes, of the
+// users payload accepted by a single bulk import job.
+const MaxImportSize = 500 * 1024
+
+var (
+	booleanFields = map[string]bool{
+		"blocked":        true,
+		"email_verified": true,
+		"phone_verified": true,
+	}
+
+	// Fields holding an object or a list, which can be given as JSON in a single column.
+	jsonFields = map[string]bool{
+		"app_metadata":         true,
+		"custom_password_hash": true,
+		"mfa_factors":          true,
+		"user_metadata":        true,
+	}
+
+	passwordHashAlgorithms = []string{
+		"argon2", "bcrypt", "hmac", "ldap", "md4", "md5", "pbkdf2", "scrypt", "sha1", "sha256", "sha512",
+	}
+
+	passwordHashEncodings = []string{"base64", "hex", "utf8"}
+
+	saltPositions = []string{"prefix", "suffix"}
+)
+
+// FromCSV converts the rows of a CSV file into users following the bulk import
+// schema. The mapping associates user fields, such as "email" or nested ones like
+// "app_metadata.plan", with the CSV column holding their value. When no mapping
+// is given, the column headers are used as user fields.
+func FromCSV(r io.Reader, mapping map[string]string) ([]map[string]interface{}, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("the CSV file is empty")
+		}
+		return nil, fmt.Errorf("failed to read the CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.TrimSpace(column)] = i
+	}
+
+	if len(mapping) == 0 {
+		mapping = make(map[string]string, len(header))
+		for column := range columns {
+			mapping[column] = column
+		}
+	}
+
+	// Sorting the fields keeps the errors, and the order in which nested fields are set, stable.
+	fields := make([]string, 0, len(mapping))
+	for field, column := range mapping {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("column %q mapped to %q is not in the CSV header", column, field)
+		}
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var users []map[string]interface{}
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read row %d: %w", row, err)
+		}
+
+		user := map[string]interface{}{}
+		for _, field := range fields {
+			value := strings.TrimSpace(record[columns[mapping[field]]])
+			if value == "" {
+				continue
+			}
+
+			parsed, err := parseFieldValue(field, value)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", row, err)
+			}
+
+			if err := setField(user, field, parsed); err != nil {
+				return nil, fmt.Errorf("row %d: %w", row, err)
+			}
+		}
+
+		if err := Validate(user); err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+
+		users = append(users, user)
+	}
+
+	if len(users) == 0 {
+		return nil, errors.New("the CSV file has no users")
+	}
+
+	return users, nil
+}
+
+func parseFieldValue(field, value string) (interface{}, error) {
+	if booleanFields[field] {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for %q, expected true or false", value, field)
+		}
+		return parsed, nil
+	}
+
+	if jsonFields[field] {
+		var parsed interface{}
+		if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+			return nil, fmt.Errorf("invalid JSON value for %q: %w", field, err)
+		}
+		return parsed, nil
+	}
+
+	return value, nil
+}
+
+// setField sets the value at the dot separated path of the user, creating nested objects as needed.
+func setField(user map[string]interface{}, path string, value interface{}) error {
+	keys := strings.Split(path, ".")
+
+	current := user
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := current[key]
+		if !ok {
+			nested := map[string]interface{}{}
+			current[key] = nested
+			current = nested
+			continue
+		}
+
+		nested, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("field %q conflicts with another mapped field", path)
+		}
+		current = nested
+	}
+
+	current[keys[len(keys)-1]] = value
+
+	return nil
+}
+
+// Validate checks that the user can be imported, including the format of its custom password hash.
+func Validate(user map[string]interface{}) error {
+	if email, _ := user["email"].(string); email == "" {
+		return errors.New("missing required field \"email\"")
+	}
+
+	if _, ok := user["custom_password_hash"]; !ok {
+		return nil
+	}
+
+	return validateCustomPasswordHash(user["custom_password_hash"])
+}
+
+func validateCustomPasswordHash(value interface{}) error {
+	passwordHash, ok := value.(map[string]interface{})
+	if !ok {
+		return errors.New("custom_password_hash must be an object")
+	}
+
+	algorithm, _ := passwordHash["algorithm"].(string)
+	if !slices.Contains(passwordHashAlgorithms, algorithm) {
+		return fmt.Errorf(
+			"unsupported custom_password_hash.algorithm %q, expected one of: %s",
+			algorithm,
+			strings.Join(passwordHashAlgorithms, ", "),
+		)
+	}
+
+	hash, _ := passwordHash["hash"].(map[string]interface{})
+	if hashValue, _ := hash["value"].(string); hashValue == "" {
+		return errors.New("missing required field \"custom_password_hash.hash.value\"")
+	}
+
+	if encoding, ok := hash["encoding"]; ok && !slices.Contains(passwordHashEncodings, fmt.Sprint(encoding)) {
+		return fmt.Errorf(
+			"unsupported custom_password_hash.hash.encoding %q, expected one of: %s",
+			encoding,
+			strings.Join(passwordHashEncodings, ", "),
+		)
+	}
+
+	if algorithm == "hmac" {
+		key, _ := hash["key"].(map[string]interface{})
+		if keyValue, _ := key["value"].(string); keyValue == "" {
+			return errors.New("missing required field \"custom_password_hash.hash.key.value\" for the hmac algorithm")
+		}
+	}
+
+	if salt, ok := passwordHash["salt"].(map[string]interface{}); ok {
+		if saltValue, _ := salt["value"].(string); saltValue == "" {
+			return errors.New("missing required field \"custom_password_hash.salt.value\"")
+		}
+
+		if position, ok := salt["position"]; ok && !slices.Contains(saltPositions, fmt.Sprint(position)) {
+			return fmt.Errorf("unsupported custom_password_hash.salt.position %q, expected prefix or suffix", position)
+		}
+	}
+
+	return nil
+}
+
+// Chunk splits the users into batches whose JSON encoding
+// fits within the maximum size of a bulk import job.
+func Chunk(users []map[string]interface{}, maxSize int) ([][]map[string]interface{}, error) {
+	var (
+		chunks [][]map[string]interface{}
+		chunk  []map[string]interface{}
+		size   int
+	)
+
+	for i, user := range users {
+		encoded, err := json.Marshal(user)
+		if err != nil {
+			return nil, err
+		}
+
+		// Account for the enclosing brackets of the list and the comma separating its items.
+		userSize := len(encoded) + 1
+		if userSize+2 > maxSize {
+			return nil, fmt.Errorf("user %d is larger than the maximum import size of %d bytes", i+1, maxSize)
+		}
+
+		if size+userSize+2 > maxSize {
+			chunks = append(chunks, chunk)
+			chunk, size = nil, 0
+		}
+
+		chunk = append(chunk, user)
+		size += userSize
+	}
+
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, nil
+}
@@ -0,0 +1,140 @@
+package users
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromCSV(t *testing.T) {
+	t.Run("maps the columns to user fields", func(t *testing.T) {
+		input := "Email,FullName,Plan,Verified\n" +
+			"jane@example.com,Jane Doe,pro,true\n" +
+			"john@example.com,John Doe,,false\n"
+
+		users, err := FromCSV(strings.NewReader(input), map[string]string{
+			"email":             "Email",
+			"name":              "FullName",
+			"app_metadata.plan": "Plan",
+			"email_verified":    "Verified",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []map[string]interface{}{
+			{
+				"email":          "jane@example.com",
+				"name":           "Jane Doe",
+				"email_verified": true,
+				"app_metadata":   map[string]interface{}{"plan": "pro"},
+			},
+			{
+				"email":          "john@example.com",
+				"name":           "John Doe",
+				"email_verified": false,
+			},
+		}, users)
+	})
+
+	t.Run("uses the header as user fields without a mapping", func(t *testing.T) {
+		input := "email,custom_password_hash\n" +
+			`jane@example.com,"{""algorithm"":""bcrypt"",""hash"":{""value"":""$2b$10$abc""}}"` + "\n"
+
+		users, err := FromCSV(strings.NewReader(input), nil)
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, "bcrypt", users[0]["custom_password_hash"].(map[string]interface{})["algorithm"])
+	})
+
+	t.Run("reports the row of invalid users", func(t *testing.T) {
+		input := "email,custom_password_hash.algorithm,custom_password_hash.hash.value\n" +
+			"jane@example.com,bcrypt,$2b$10$abc\n" +
+			"john@example.com,rot13,abc\n"
+
+		_, err := FromCSV(strings.NewReader(input), nil)
+		assert.ErrorContains(t, err, `row 3: unsupported custom_password_hash.algorithm "rot13"`)
+	})
+
+	t.Run("rejects mappings to unknown columns", func(t *testing.T) {
+		_, err := FromCSV(strings.NewReader("Email\njane@example.com\n"), map[string]string{"email": "Mail"})
+		assert.EqualError(t, err, `column "Mail" mapped to "email" is not in the CSV header`)
+	})
+
+	t.Run("rejects users without an email", func(t *testing.T) {
+		_, err := FromCSV(strings.NewReader("email,name\n,Jane Doe\n"), nil)
+		assert.EqualError(t, err, `row 2: missing required field "email"`)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	var tests = []struct {
+		name         string
+		passwordHash string
+		expectedErr  string
+	}{
+		{
+			name:         "valid hash with salt",
+			passwordHash: `{"algorithm":"sha256","hash":{"value":"abc","encoding":"hex"},"salt":{"value":"xyz","position":"prefix"}}`,
+		},
+		{
+			name:         "missing hash value",
+			passwordHash: `{"algorithm":"md5","hash":{}}`,
+			expectedErr:  `missing required field "custom_password_hash.hash.value"`,
+		},
+		{
+			name:         "unsupported encoding",
+			passwordHash: `{"algorithm":"md5","hash":{"value":"abc","encoding":"base32"}}`,
+			expectedErr:  `unsupported custom_password_hash.hash.encoding "base32", expected one of: base64, hex, utf8`,
+		},
+		{
+			name:         "hmac without key",
+			passwordHash: `{"algorithm":"hmac","hash":{"value":"abc"}}`,
+			expectedErr:  `missing required field "custom_password_hash.hash.key.value" for the hmac algorithm`,
+		},
+		{
+			name:         "unsupported salt position",
+			passwordHash: `{"algorithm":"sha1","hash":{"value":"abc"},"salt":{"value":"xyz","position":"middle"}}`,
+			expectedErr:  `unsupported custom_password_hash.salt.position "middle", expected prefix or suffix`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var passwordHash map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(test.passwordHash), &passwordHash))
+
+			err := Validate(map[string]interface{}{"email": "jane@example.com", "custom_password_hash": passwordHash})
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	var users []map[string]interface{}
+	for i := 0; i < 10; i++ {
+		users = append(users, map[string]interface{}{"email": "user@example.com"})
+	}
+
+	// Each user is encoded as {"email":"user@example.com"} which is 28 bytes long.
+	chunks, err := Chunk(users, 100)
+	require.NoError(t, err)
+	require.Len(t, chunks, 4)
+
+	var total int
+	for _, chunk := range chunks {
+		encoded, err := json.Marshal(chunk)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(encoded), 100)
+		total += len(chunk)
+	}
+	assert.Equal(t, 10, total)
+
+	_, err = Chunk(users, 20)
+	assert.EqualError(t, err, "user 1 is larger than the maximum import size of 20 bytes")
+}
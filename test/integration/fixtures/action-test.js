@@ -0,0 +1,4 @@
+exports.onExecutePostLogin = async (event, api) => {
+  console.log(`Logging in ${event.user.email}`);
+  api.accessToken.setCustomClaim("https://example.com/email", event.user.email);
+};
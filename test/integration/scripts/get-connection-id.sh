@@ -0,0 +1,13 @@
+#! /bin/bash
+
+FILE=./test/integration/identifiers/connection-id
+if [ -f "$FILE" ]; then
+    cat $FILE
+    exit 0
+fi
+
+connection=$( auth0 connections create -n integration-test-connection-newConnection -s auth0 --password-policy fair --json --no-input )
+
+mkdir -p ./test/integration/identifiers
+echo "$connection" | jq -r '.["id"]' > $FILE
+cat $FILE
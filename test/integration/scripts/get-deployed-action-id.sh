@@ -0,0 +1,25 @@
+#! /bin/bash
+
+FILE=./test/integration/identifiers/deployed-action-id
+if [ -f "$FILE" ]; then
+    cat $FILE
+    exit 0
+fi
+
+action=$( auth0 actions create -n "integration-test-action-trigger" -t "post-login" -c "exports.onExecutePostLogin = async (event, api) => {};" --json --no-input )
+id=$( echo "$action" | jq -r '.["id"]' )
+
+# Actions can only be bound to a trigger once built, so wait until the build completes before deploying.
+for _ in {1..10}; do
+  status=$( auth0 actions show "$id" --json | jq -r '.["status"]' )
+  if [ "$status" == "built" ]; then
+    break
+  fi
+  sleep 2
+done
+
+auth0 actions deploy "$id" --json > /dev/null
+
+mkdir -p ./test/integration/identifiers
+echo "$id" > $FILE
+cat $FILE
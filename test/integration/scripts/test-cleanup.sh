@@ -26,8 +26,13 @@ then
 fi
 
 delete_resources "roles" "integration-test-role" "id"
+delete_resources "connections" "integration-test-connection" "id"
 delete_resources "rules" "integration-test-rule" "id"
 delete_resources "orgs" "integration-test-org" "id"
+delete_resources "self-service-profiles" "integration-test-ssp" "id"
+delete_resources "user-attribute-profiles" "integration-test-uap" "id"
+delete_resources "forms" "integration-test-form" "id"
+delete_resources "flows" "integration-test-flow" "id"
 delete_resources "actions" "integration-test-" "id"
 delete_resources "actions modules" "integration-test-module" "id"
 delete_resources "token-exchange" "integration-test-" "id"
@@ -48,3 +53,7 @@ rm -rf integration-test-app-qs
 rm -rf test/integration/identifiers
 
 rm -rdf tmp-tf-gen
+
+rm -rf integration-test-tenant
+
+rm -f integration-test-logs.ndjson